@@ -21,7 +21,7 @@ type Iterator[T any] interface {
 
 // Defines a function that can test equality of two variable of the same type
 // If you don't want to implement, a collection.DefaultEqualizer[T]() is provided, which
-// uses reflect.DeepEquals(v1, v2 T) for simplicity
+// uses Equals() of Equatable types, and reflect.DeepEquals(v1, v2 T) for the rest
 type Equalizer[T any] func(T, T) bool
 
 // Defines common APIs a Collection should support
@@ -82,12 +82,15 @@ type Collection[T any] interface {
 	Clear() (numberOfItemsRemoved int)
 }
 
-// Reflection.DeepEqual
+// Equatable.Equals if v1 implements it, reflection.DeepEqual otherwise
 func EqualsTester[T any](v1, v2 T) bool {
+	if equatable, ok := any(v1).(Equatable[T]); ok && !isNil(v1) {
+		return equatable.Equals(v2)
+	}
 	return reflect.DeepEqual(v1, v2)
 }
 
-// Default equalizer that uses reflection.DeepEquals
+// Default equalizer that uses Equatable.Equals when available, reflection.DeepEquals otherwise
 func DefaultEqualizer[T any]() Equalizer[T] {
 	return EqualsTester[T]
 }
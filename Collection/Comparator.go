@@ -0,0 +1,94 @@
+package Collection
+
+import "reflect"
+
+// Defines a function that compares two values of the same type.
+// Returns a negative number when v1 < v2, zero when v1 == v2, and a positive number when v1 > v2
+type Comparator[T any] func(v1, v2 T) int
+
+// Types that support the < operator
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// Compares ordered values with < and >
+func NaturalOrder[T Ordered]() Comparator[T] {
+	return func(v1, v2 T) int {
+		if v1 < v2 {
+			return -1
+		}
+		if v1 > v2 {
+			return 1
+		}
+		return 0
+	}
+}
+
+// Compares values by the ordered key extracted from them.
+// Comparing(func(p Person) string { return p.Name })
+func Comparing[T any, K Ordered](keyExtractor func(T) K) Comparator[T] {
+	return ComparingFunc(keyExtractor, NaturalOrder[K]())
+}
+
+// Compares values by the key extracted from them, using keyComparator for the keys
+func ComparingFunc[T any, K any](keyExtractor func(T) K, keyComparator Comparator[K]) Comparator[T] {
+	return func(v1, v2 T) int {
+		return keyComparator(keyExtractor(v1), keyExtractor(v2))
+	}
+}
+
+// Returns a comparator for the opposite order
+func (v Comparator[T]) Reversed() Comparator[T] {
+	return func(v1, v2 T) int {
+		return v(v2, v1)
+	}
+}
+
+// Returns a comparator that uses other when this one finds two values equal
+func (v Comparator[T]) ThenComparing(other Comparator[T]) Comparator[T] {
+	return func(v1, v2 T) int {
+		if result := v(v1, v2); result != 0 {
+			return result
+		}
+		return other(v1, v2)
+	}
+}
+
+// Returns a comparator that puts nil (nil pointer, interface, slice, map, chan or func) before everything else,
+// and compares the rest with comparator. A nil comparator treats all non nil values as equal
+func NullsFirst[T any](comparator Comparator[T]) Comparator[T] {
+	return nullsComparator(comparator, -1)
+}
+
+// Same as NullsFirst, but puts nil after everything else
+func NullsLast[T any](comparator Comparator[T]) Comparator[T] {
+	return nullsComparator(comparator, 1)
+}
+
+func nullsComparator[T any](comparator Comparator[T], nilOrder int) Comparator[T] {
+	return func(v1, v2 T) int {
+		nil1, nil2 := isNil(v1), isNil(v2)
+		switch {
+		case nil1 && nil2:
+			return 0
+		case nil1:
+			return nilOrder
+		case nil2:
+			return -nilOrder
+		case comparator == nil:
+			return 0
+		}
+		return comparator(v1, v2)
+	}
+}
+
+func isNil[T any](value T) bool {
+	rv := reflect.ValueOf(&value).Elem()
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return rv.IsNil()
+	}
+	return false
+}
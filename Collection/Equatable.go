@@ -0,0 +1,13 @@
+package Collection
+
+// Types that decide for themselves when they are equal to another value.
+// DefaultEqualizer uses Equals() instead of reflection when the type implements it
+type Equatable[T any] interface {
+	Equals(other T) bool
+}
+
+// Types that compute their own hash. DefaultHasher uses HashCode() instead of reflection when the type implements it.
+// Like Java's hashCode(), values equal by Equals() must have the same HashCode()
+type Hashable interface {
+	HashCode() uint64
+}
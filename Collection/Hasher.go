@@ -0,0 +1,38 @@
+package Collection
+
+import "github.com/wushilin/gojava/internal/hashing"
+
+// Defines a function that hashes a value. Values that are equal by the Equalizer used alongside
+// must have the same hash
+type Hasher[T any] func(T) uint64
+
+// Default hasher, consistent with DefaultEqualizer. It uses HashCode() of Hashable types, and walks
+// everything else with reflection: slices and maps are hashed by content, pointers by what they point to.
+// Types that are Equatable but not Hashable all hash to 0, since reflection can't know what Equals() ignores.
+// That keeps hash containers correct but makes them linear, implement Hashable as well
+func DefaultHasher[T any]() Hasher[T] {
+	return func(v T) uint64 {
+		if hashable, ok := any(v).(Hashable); ok && !isNil(v) {
+			return hashable.HashCode()
+		}
+		if _, ok := any(v).(Equatable[T]); ok {
+			return 0
+		}
+		return hashing.Deep(v)
+	}
+}
+
+// Hashes values by the comparable key extracted from them.
+// HashingBy(strings.ToLower) together with EqualizingBy(strings.ToLower) ignores case
+func HashingBy[T any, K comparable](key func(T) K) Hasher[T] {
+	return func(v T) uint64 {
+		return hashing.Comparable(key(v))
+	}
+}
+
+// Tests values for equality by the comparable key extracted from them
+func EqualizingBy[T any, K comparable](key func(T) K) Equalizer[T] {
+	return func(v1, v2 T) bool {
+		return key(v1) == key(v2)
+	}
+}
@@ -0,0 +1,318 @@
+// Algorithms on top of the List and Collection interfaces, like java.util.Collections.
+// ArrayList and CopyOnWriteArrayList are accessed by index, other lists through their iterators.
+// CopyOnWriteArrayList is modified with a single Update(), so it is copied once and readers see all or nothing
+package Collections
+
+import (
+	"math/rand"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+)
+
+// Whether Get() and Set() are O(1)
+func randomAccess[T any](l list.List[T]) bool {
+	switch l.(type) {
+	case *list.ArrayList[T], *list.CopyOnWriteArrayList[T]:
+		return true
+	}
+	return false
+}
+
+// Run update on the array of l if it is a CopyOnWriteArrayList. Returns false if it is not
+func updateArray[T any](l list.List[T], update func(data []T)) bool {
+	if cow, ok := l.(*list.CopyOnWriteArrayList[T]); ok {
+		cow.Update(update)
+		return true
+	}
+	return false
+}
+
+// Overwrite the elements of l with data, which must have the same size
+func writeBack[T any](l list.List[T], data []T) {
+	if randomAccess(l) {
+		for index, next := range data {
+			l.Set(index, next)
+		}
+		return
+	}
+	iter := l.ListIterator()
+	for _, next := range data {
+		iter.Next()
+		iter.Set(next)
+	}
+}
+
+// Search key in l, which must be sorted by comparator.
+// Returns the index of key if found, otherwise -(insertion point) - 1, which is always negative
+func BinarySearch[T any](l list.List[T], key T, comparator coll.Comparator[T]) int {
+	low, high := 0, l.Size()-1
+	var iter list.ListIterator[T]
+	if !randomAccess(l) {
+		iter = l.ListIterator()
+	}
+	for low <= high {
+		middle := int(uint(low+high) >> 1)
+		var value T
+		if iter == nil {
+			value = l.Get(middle)
+		} else {
+			value = moveTo(iter, middle)
+		}
+		result := comparator(value, key)
+		if result < 0 {
+			low = middle + 1
+		} else if result > 0 {
+			high = middle - 1
+		} else {
+			return middle
+		}
+	}
+	return -(low + 1)
+}
+
+// Move iter so it returns the element at index
+func moveTo[T any](iter list.ListIterator[T], index int) (result T) {
+	for iter.NextIndex() <= index {
+		result, _ = iter.Next()
+	}
+	for iter.NextIndex() > index {
+		result, _ = iter.Previous()
+	}
+	return
+}
+
+// Randomly permute l using rnd. A nil rnd uses the default source of math/rand
+func Shuffle[T any](l list.List[T], rnd *rand.Rand) {
+	intn := rand.Intn
+	if rnd != nil {
+		intn = rnd.Intn
+	}
+	shuffle := func(data []T) {
+		for i := len(data) - 1; i > 0; i-- {
+			j := intn(i + 1)
+			data[i], data[j] = data[j], data[i]
+		}
+	}
+	if updateArray(l, shuffle) {
+		return
+	}
+	if randomAccess(l) {
+		for i := l.Size() - 1; i > 0; i-- {
+			Swap(l, i, intn(i+1))
+		}
+		return
+	}
+	data := l.ToArray()
+	shuffle(data)
+	writeBack(l, data)
+}
+
+// Swap the elements at i and j
+func Swap[T any](l list.List[T], i, j int) {
+	swapped := updateArray(l, func(data []T) {
+		if i < 0 || i >= len(data) || j < 0 || j >= len(data) {
+			panic("Index Out of Bound")
+		}
+		data[i], data[j] = data[j], data[i]
+	})
+	if !swapped {
+		l.Set(i, l.Set(j, l.Get(i)))
+	}
+}
+
+// Move every element distance positions to the right, wrapping around the end.
+// [1,2,3,4,5] rotated by 1 => [5,1,2,3,4], by -1 => [2,3,4,5,1]
+func Rotate[T any](l list.List[T], distance int) {
+	if updateArray(l, func(data []T) { rotate(data, distance) }) {
+		return
+	}
+	data := l.ToArray()
+	if rotate(data, distance) {
+		writeBack(l, data)
+	}
+}
+
+// Rotate data in place. Returns false if that changes nothing
+func rotate[T any](data []T, distance int) bool {
+	size := len(data)
+	if size == 0 {
+		return false
+	}
+	distance %= size
+	if distance < 0 {
+		distance += size
+	}
+	if distance == 0 {
+		return false
+	}
+	rotated := make([]T, size)
+	copy(rotated[distance:], data[:size-distance])
+	copy(rotated, data[size-distance:])
+	copy(data, rotated)
+	return true
+}
+
+// Set every element of l to value
+func Fill[T any](l list.List[T], value T) {
+	if updateArray(l, func(data []T) {
+		for i := range data {
+			data[i] = value
+		}
+	}) {
+		return
+	}
+	if randomAccess(l) {
+		for i := 0; i < l.Size(); i++ {
+			l.Set(i, value)
+		}
+		return
+	}
+	iter := l.Iterator()
+	for _, ok := iter.Next(); ok; _, ok = iter.Next() {
+		iter.Set(value)
+	}
+}
+
+// Returns a new list with n copies of value
+func NCopies[T any](n int, value T) list.List[T] {
+	if n < 0 {
+		panic("Negative count")
+	}
+	result := list.NewArrayList[T]()
+	for i := 0; i < n; i++ {
+		result.Add(value)
+	}
+	return result
+}
+
+// Count the elements equal to value, with the default equalizer
+func Frequency[T any](c coll.Collection[T], value T) int {
+	return FrequencyFunc(c, value, coll.DefaultEqualizer[T]())
+}
+
+// Count the elements equal to value
+func FrequencyFunc[T any](c coll.Collection[T], value T, equals coll.Equalizer[T]) int {
+	count := 0
+	c.ForEach(func(i T) bool {
+		if equals(i, value) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+// Whether c1 and c2 have no element in common, with the default equalizer
+func Disjoint[T any](c1, c2 coll.Collection[T]) bool {
+	return DisjointFunc(c1, c2, coll.DefaultEqualizer[T]())
+}
+
+// Whether c1 and c2 have no element in common
+func DisjointFunc[T any](c1, c2 coll.Collection[T], equals coll.Equalizer[T]) bool {
+	if c1.Size() > c2.Size() {
+		// Iterate the smaller one
+		c1, c2 = c2, c1
+	}
+	disjoint := true
+	c1.ForEach(func(i T) bool {
+		disjoint = !c2.ContainsFunc(i, equals)
+		return disjoint
+	})
+	return disjoint
+}
+
+// Returns the least element according to comparator. ok is false if c is empty
+func Min[T any](c coll.Collection[T], comparator coll.Comparator[T]) (result T, ok bool) {
+	c.ForEach(func(i T) bool {
+		if !ok || comparator(i, result) < 0 {
+			result, ok = i, true
+		}
+		return true
+	})
+	return
+}
+
+// Returns the greatest element according to comparator. ok is false if c is empty
+func Max[T any](c coll.Collection[T], comparator coll.Comparator[T]) (T, bool) {
+	return Min(c, comparator.Reversed())
+}
+
+// Returns the first index where target occurs in source, or -1. Uses the default equalizer
+func IndexOfSubList[T any](source, target list.List[T]) int {
+	return IndexOfSubListFunc(source, target, coll.DefaultEqualizer[T]())
+}
+
+// Returns the first index where target occurs in source, or -1
+func IndexOfSubListFunc[T any](source, target list.List[T], equals coll.Equalizer[T]) int {
+	data, pattern := source.ToArray(), target.ToArray()
+	for start := 0; start+len(pattern) <= len(data); start++ {
+		if matchesAt(data, pattern, start, equals) {
+			return start
+		}
+	}
+	return -1
+}
+
+// Returns the last index where target occurs in source, or -1. Uses the default equalizer
+func LastIndexOfSubList[T any](source, target list.List[T]) int {
+	return LastIndexOfSubListFunc(source, target, coll.DefaultEqualizer[T]())
+}
+
+// Returns the last index where target occurs in source, or -1
+func LastIndexOfSubListFunc[T any](source, target list.List[T], equals coll.Equalizer[T]) int {
+	data, pattern := source.ToArray(), target.ToArray()
+	for start := len(data) - len(pattern); start >= 0; start-- {
+		if matchesAt(data, pattern, start, equals) {
+			return start
+		}
+	}
+	return -1
+}
+
+func matchesAt[T any](data, pattern []T, start int, equals coll.Equalizer[T]) bool {
+	for i, next := range pattern {
+		if !equals(data[start+i], next) {
+			return false
+		}
+	}
+	return true
+}
+
+// Replace every element equal to oldValue with newValue, using the default equalizer.
+// Returns whether anything was replaced
+func ReplaceAll[T any](l list.List[T], oldValue, newValue T) bool {
+	return ReplaceAllFunc(l, oldValue, newValue, coll.DefaultEqualizer[T]())
+}
+
+// Replace every element equal to oldValue with newValue. Returns whether anything was replaced
+func ReplaceAllFunc[T any](l list.List[T], oldValue, newValue T, equals coll.Equalizer[T]) bool {
+	replaced := false
+	if updateArray(l, func(data []T) {
+		for i, next := range data {
+			if equals(next, oldValue) {
+				data[i] = newValue
+				replaced = true
+			}
+		}
+	}) {
+		return replaced
+	}
+	if randomAccess(l) {
+		for i := 0; i < l.Size(); i++ {
+			if equals(l.Get(i), oldValue) {
+				l.Set(i, newValue)
+				replaced = true
+			}
+		}
+		return replaced
+	}
+	iter := l.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if equals(next, oldValue) {
+			iter.Set(newValue)
+			replaced = true
+		}
+	}
+	return replaced
+}
@@ -0,0 +1,90 @@
+package Collections
+
+import (
+	"math/rand"
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/gojava/common"
+)
+
+func testAlgorithms(t *testing.T, l list.List[int]) {
+	for i := 0; i < 100; i += 2 {
+		l.Add(i)
+	}
+	natural := coll.NaturalOrder[int]()
+	common.AssertEq(t, BinarySearch(l, 40, natural), 20)
+	common.AssertEq(t, BinarySearch(l, 41, natural), -22)
+	common.AssertEq(t, BinarySearch(l, -1, natural), -1)
+	common.AssertEq(t, BinarySearch(l, 100, natural), -51)
+
+	Shuffle(l, rand.New(rand.NewSource(1)))
+	common.AssertEq(t, l.Size(), 50)
+	min, _ := Min[int](l, natural)
+	max, _ := Max[int](l, natural)
+	common.AssertEq(t, min, 0)
+	common.AssertEq(t, max, 98)
+	l.Sort(natural)
+	common.AssertEq(t, l.Get(49), 98)
+
+	l.Clear()
+	l.AddAll(list.ArrayListOf(1, 2, 3, 4, 5))
+	Rotate(l, 1)
+	common.AssertArrEq(t, l.ToArray(), []int{5, 1, 2, 3, 4})
+	Rotate(l, -2)
+	common.AssertArrEq(t, l.ToArray(), []int{2, 3, 4, 5, 1})
+	Swap(l, 0, 4)
+	common.AssertArrEq(t, l.ToArray(), []int{1, 3, 4, 5, 2})
+	common.AssertTrue(t, ReplaceAll(l, 3, 2))
+	common.AssertFalse(t, ReplaceAll(l, 3, 2))
+	common.AssertEq(t, Frequency[int](l, 2), 2)
+	common.AssertEq(t, IndexOfSubList[int](l, list.ArrayListOf(4, 5)), 2)
+	common.AssertEq(t, IndexOfSubList[int](l, list.ArrayListOf(5, 4)), -1)
+	common.AssertEq(t, LastIndexOfSubList[int](l, list.ArrayListOf(2)), 4)
+	Fill(l, 7)
+	common.AssertArrEq(t, l.ToArray(), []int{7, 7, 7, 7, 7})
+}
+
+func TestCollections(t *testing.T) {
+	testAlgorithms(t, list.NewArrayList[int]())
+	testAlgorithms(t, list.NewLinkedList[int]())
+	testAlgorithms(t, list.NewCopyOnWriteArrayList[int]())
+
+	common.AssertArrEq(t, NCopies(3, "a").ToArray(), []string{"a", "a", "a"})
+	common.AssertTrue(t, Disjoint[int](list.ArrayListOf(1, 2), list.LinkedListOf(3, 4, 5)))
+	common.AssertFalse(t, Disjoint[int](list.ArrayListOf(1, 2), list.LinkedListOf(3, 2)))
+	_, ok := Min[int](list.NewArrayList[int](), coll.NaturalOrder[int]())
+	common.AssertFalse(t, ok)
+}
+
+func TestCopyOnWriteAlgorithms(t *testing.T) {
+	l := list.NewCopyOnWriteArrayList[int]()
+	for i := 0; i < 100; i++ {
+		l.Add(i)
+	}
+	stop := make(chan struct{})
+	done := make(chan bool)
+	go func() {
+		consistent := true
+		for {
+			select {
+			case <-stop:
+				done <- consistent
+				return
+			default:
+			}
+			// Readers see the list before or after a rotation, never in between
+			data := l.ToArray()
+			for i := 1; i < len(data); i++ {
+				consistent = consistent && data[i] == (data[i-1]+1)%len(data)
+			}
+		}
+	}()
+	for i := 0; i < 1000; i++ {
+		Rotate[int](l, 7)
+	}
+	close(stop)
+	common.AssertTrue(t, <-done)
+	common.AssertEq(t, l.Get(0), 0)
+}
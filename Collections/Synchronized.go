@@ -0,0 +1,530 @@
+package Collections
+
+import (
+	"sync"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	mp "github.com/wushilin/gojava/Map"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+// Guards c with lock. ForEach() visits a snapshot taken under the lock, so the visitor can use this collection.
+// Methods that take a collection copy its elements before locking, so s.AddAll(s) works.
+// Equalizers of read only methods run on a snapshot too. The callbacks of methods that modify run under the lock
+// and must not use this collection, the lock is not reentrant.
+// Iterators only hold the lock during each call, so modifying c from another goroutine while iterating still panics
+type synchronizedCollection[T any] struct {
+	lock *sync.Mutex
+	c    coll.Collection[T]
+}
+
+// Returns a view of c that is safe for concurrent use. Every method locks the same mutex.
+// c must not be used directly afterwards
+func SynchronizedCollection[T any](c coll.Collection[T]) coll.Collection[T] {
+	return &synchronizedCollection[T]{&sync.Mutex{}, c}
+}
+
+// Returns a view of s that is safe for concurrent use. See SynchronizedCollection
+func SynchronizedSet[T comparable](s set.Set[T]) set.Set[T] {
+	return &synchronizedCollection[T]{&sync.Mutex{}, s}
+}
+
+// Visits a snapshot, so visitor can use this collection
+func (v *synchronizedCollection[T]) ForEach(visitor coll.Visitor[T]) int {
+	count := 0
+	for _, next := range v.ToArray() {
+		count++
+		if !visitor(next) {
+			break
+		}
+	}
+	return count
+}
+
+// Copy of the elements of c, taken before locking since c may be this collection or share its lock
+func snapshotOf[T any](c coll.Collection[T]) coll.Collection[T] {
+	return list.ArrayListOf(c.ToArray()...)
+}
+
+func (v *synchronizedCollection[T]) Add(element T) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.Add(element)
+}
+
+func (v *synchronizedCollection[T]) AddAll(elements coll.Collection[T]) int {
+	elements = snapshotOf(elements)
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.AddAll(elements)
+}
+
+// Searches a snapshot, so equals can use this collection
+func (v *synchronizedCollection[T]) ContainsFunc(what T, equals coll.Equalizer[T]) bool {
+	return list.ArrayListOf(v.ToArray()...).ContainsFunc(what, equals)
+}
+
+func (v *synchronizedCollection[T]) Contains(data T) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.Contains(data)
+}
+
+func (v *synchronizedCollection[T]) IsEmpty() bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.IsEmpty()
+}
+
+func (v *synchronizedCollection[T]) Iterator() coll.Iterator[T] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return &synchronizedIterator[T]{v.lock, v.c.Iterator()}
+}
+
+// equals is called under the lock, it must not use this collection
+func (v *synchronizedCollection[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	collection = snapshotOf(collection)
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.RemoveAllFunc(collection, equals)
+}
+
+func (v *synchronizedCollection[T]) RemoveAll(collection coll.Collection[T]) int {
+	collection = snapshotOf(collection)
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.RemoveAll(collection)
+}
+
+// equals is called under the lock, it must not use this collection
+func (v *synchronizedCollection[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	collection = snapshotOf(collection)
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.RetainAllFunc(collection, equals)
+}
+
+func (v *synchronizedCollection[T]) RetainAll(collection coll.Collection[T]) int {
+	collection = snapshotOf(collection)
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.RetainAll(collection)
+}
+
+func (v *synchronizedCollection[T]) Size() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.Size()
+}
+
+func (v *synchronizedCollection[T]) ToArray() []T {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.ToArray()
+}
+
+// Stream over a snapshot
+func (v *synchronizedCollection[T]) Stream() stream.Stream[T] {
+	return stream.FromArray(v.ToArray())
+}
+
+func (v *synchronizedCollection[T]) Clear() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.c.Clear()
+}
+
+type synchronizedIterator[T any] struct {
+	lock *sync.Mutex
+	iter coll.Iterator[T]
+}
+
+func (v *synchronizedIterator[T]) Next() (T, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.iter.Next()
+}
+
+func (v *synchronizedIterator[T]) Remove() {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.iter.Remove()
+}
+
+func (v *synchronizedIterator[T]) Set(data T) T {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.iter.Set(data)
+}
+
+type synchronizedList[T any] struct {
+	synchronizedCollection[T]
+	l list.List[T]
+}
+
+// Returns a view of l that is safe for concurrent use. See SynchronizedCollection.
+// SubList() views share the lock of the list
+func SynchronizedList[T any](l list.List[T]) list.List[T] {
+	return newSynchronizedList(&sync.Mutex{}, l)
+}
+
+func newSynchronizedList[T any](lock *sync.Mutex, l list.List[T]) list.List[T] {
+	return &synchronizedList[T]{synchronizedCollection[T]{lock, l}, l}
+}
+
+func (v *synchronizedList[T]) AddAt(index int, element T) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.AddAt(index, element)
+}
+
+func (v *synchronizedList[T]) AddAllAt(index int, elements coll.Collection[T]) int {
+	elements = snapshotOf(elements)
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.AddAllAt(index, elements)
+}
+
+func (v *synchronizedList[T]) Get(index int) T {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.Get(index)
+}
+
+// Searches a snapshot, so equals can use this list
+func (v *synchronizedList[T]) IndexOfFunc(what T, equals coll.Equalizer[T]) int {
+	return list.ArrayListOf(v.ToArray()...).IndexOfFunc(what, equals)
+}
+
+func (v *synchronizedList[T]) IndexOf(what T) int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.IndexOf(what)
+}
+
+// Searches a snapshot, so equals can use this list
+func (v *synchronizedList[T]) LastIndexOfFunc(what T, equals coll.Equalizer[T]) int {
+	return list.ArrayListOf(v.ToArray()...).LastIndexOfFunc(what, equals)
+}
+
+func (v *synchronizedList[T]) LastIndexOf(what T) int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.LastIndexOf(what)
+}
+
+func (v *synchronizedList[T]) Set(index int, newValue T) T {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.Set(index, newValue)
+}
+
+// equals is called under the lock, it must not use this list
+func (v *synchronizedList[T]) RemoveFirstFunc(data T, equals coll.Equalizer[T]) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.RemoveFirstFunc(data, equals)
+}
+
+func (v *synchronizedList[T]) RemoveFirst(data T) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.RemoveFirst(data)
+}
+
+func (v *synchronizedList[T]) RemoveAt(index int) T {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.RemoveAt(index)
+}
+
+func (v *synchronizedList[T]) CopySubList(fromIndexIncluded int, endIndexExcluded int) list.List[T] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.CopySubList(fromIndexIncluded, endIndexExcluded)
+}
+
+func (v *synchronizedList[T]) SubList(fromIndexIncluded int, endIndexExcluded int) list.List[T] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return newSynchronizedList(v.lock, v.l.SubList(fromIndexIncluded, endIndexExcluded))
+}
+
+func (v *synchronizedList[T]) Copy() list.List[T] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.Copy()
+}
+
+func (v *synchronizedList[T]) Reverse() list.List[T] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.l.Reverse()
+}
+
+// comparator is called under the lock, it must not use this list
+func (v *synchronizedList[T]) Sort(comparator coll.Comparator[T]) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.l.Sort(comparator)
+}
+
+func (v *synchronizedList[T]) ListIterator() list.ListIterator[T] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return &synchronizedListIterator[T]{v.lock, v.l.ListIterator()}
+}
+
+func (v *synchronizedList[T]) ListIteratorAt(index int) list.ListIterator[T] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return &synchronizedListIterator[T]{v.lock, v.l.ListIteratorAt(index)}
+}
+
+type synchronizedListIterator[T any] struct {
+	lock *sync.Mutex
+	iter list.ListIterator[T]
+}
+
+func (v *synchronizedListIterator[T]) Next() (T, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.iter.Next()
+}
+
+func (v *synchronizedListIterator[T]) HasNext() bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.iter.HasNext()
+}
+
+func (v *synchronizedListIterator[T]) HasPrevious() bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.iter.HasPrevious()
+}
+
+func (v *synchronizedListIterator[T]) Previous() (T, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.iter.Previous()
+}
+
+func (v *synchronizedListIterator[T]) NextIndex() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.iter.NextIndex()
+}
+
+func (v *synchronizedListIterator[T]) PreviousIndex() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.iter.PreviousIndex()
+}
+
+func (v *synchronizedListIterator[T]) Remove() {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.iter.Remove()
+}
+
+func (v *synchronizedListIterator[T]) Set(data T) T {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.iter.Set(data)
+}
+
+func (v *synchronizedListIterator[T]) Add(data T) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.iter.Add(data)
+}
+
+type synchronizedMap[K comparable, V any] struct {
+	lock sync.Mutex
+	m    mp.Map[K, V]
+}
+
+// Returns a view of m that is safe for concurrent use. Every method locks the same mutex.
+// Iterators only hold the lock during each call. m must not be used directly afterwards.
+// Like SynchronizedCollection, ForEach and ContainsValueFunc call back on a snapshot, while the mapping functions
+// of Compute, Merge and ReplaceAll run under the lock
+func SynchronizedMap[K comparable, V any](m mp.Map[K, V]) mp.Map[K, V] {
+	return &synchronizedMap[K, V]{m: m}
+}
+
+func (v *synchronizedMap[K, V]) Size() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.Size()
+}
+
+func (v *synchronizedMap[K, V]) Contains(key K) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.Contains(key)
+}
+
+func (v *synchronizedMap[K, V]) Get(key K) (V, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.Get(key)
+}
+
+func (v *synchronizedMap[K, V]) Put(key K, value V) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.m.Put(key, value)
+}
+
+// other is copied before locking, so it can be this map
+func (v *synchronizedMap[K, V]) PutAll(other mp.Map[K, V]) {
+	copied := mp.NewLinkedHashMap[K, V]()
+	copied.PutAll(other)
+	other = copied
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.m.PutAll(other)
+}
+
+func (v *synchronizedMap[K, V]) Remove(key K) (V, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.Remove(key)
+}
+
+func (v *synchronizedMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.GetOrDefault(key, defaultValue)
+}
+
+func (v *synchronizedMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.PutIfAbsent(key, value)
+}
+
+// mapping is called under the lock, it must not use this map
+func (v *synchronizedMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.ComputeIfAbsent(key, mapping)
+}
+
+// remapping is called under the lock, it must not use this map
+func (v *synchronizedMap[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (V, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.ComputeIfPresent(key, remapping)
+}
+
+// remapping is called under the lock, it must not use this map
+func (v *synchronizedMap[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (V, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.Compute(key, remapping)
+}
+
+// remapping is called under the lock, it must not use this map
+func (v *synchronizedMap[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (V, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.Merge(key, value, remapping)
+}
+
+func (v *synchronizedMap[K, V]) Replace(key K, value V) (V, bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.Replace(key, value)
+}
+
+// function is called under the lock, it must not use this map
+func (v *synchronizedMap[K, V]) ReplaceAll(function func(key K, value V) V) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.m.ReplaceAll(function)
+}
+
+// Visits a snapshot, so visitor can use this map
+func (v *synchronizedMap[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	v.lock.Lock()
+	entries := []mp.KV[K, V]{}
+	coll.ForEach(v.m.Iterator(), func(i mp.KV[K, V]) bool {
+		entries = append(entries, i)
+		return true
+	})
+	v.lock.Unlock()
+	count := 0
+	for _, next := range entries {
+		count++
+		if !visitor(next.Key(), next.Value()) {
+			break
+		}
+	}
+	return count
+}
+
+func (v *synchronizedMap[K, V]) RemoveAll(keys coll.Collection[K]) {
+	keys = snapshotOf(keys)
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.m.RemoveAll(keys)
+}
+
+// Searches a snapshot of the values, so equals can use this map
+func (v *synchronizedMap[K, V]) ContainsValueFunc(value V, equals coll.Equalizer[V]) bool {
+	return list.ArrayListOf(v.Values().ToArray()...).ContainsFunc(value, equals)
+}
+
+func (v *synchronizedMap[K, V]) ContainsValue(value V) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.ContainsValue(value)
+}
+
+func (v *synchronizedMap[K, V]) Iterator() coll.Iterator[mp.KV[K, V]] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return &synchronizedIterator[mp.KV[K, V]]{&v.lock, v.m.Iterator()}
+}
+
+func (v *synchronizedMap[K, V]) Keys() set.Set[K] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.Keys()
+}
+
+func (v *synchronizedMap[K, V]) Values() coll.Collection[V] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.m.Values()
+}
+
+// Live views built on the methods of this map, each of their operations locks it
+func (v *synchronizedMap[K, V]) KeySet() set.Set[K] {
+	return mp.KeySet[K, V](v)
+}
+
+func (v *synchronizedMap[K, V]) ValuesView() coll.Collection[V] {
+	return mp.ValuesView[K, V](v)
+}
+
+func (v *synchronizedMap[K, V]) EntrySet() coll.Collection[mp.Entry[K, V]] {
+	return mp.EntrySet[K, V](v)
+}
+
+// Stream over a snapshot
+func (v *synchronizedMap[K, V]) Stream() stream.Stream[mp.KV[K, V]] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	entries := []mp.KV[K, V]{}
+	coll.ForEach(v.m.Iterator(), func(i mp.KV[K, V]) bool {
+		entries = append(entries, i)
+		return true
+	})
+	return stream.FromArray(entries)
+}
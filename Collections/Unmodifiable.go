@@ -0,0 +1,361 @@
+package Collections
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	mp "github.com/wushilin/gojava/Map"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+func unsupported() {
+	panic("Unsupported operation: collection is unmodifiable")
+}
+
+type unmodifiableCollection[T any] struct {
+	c coll.Collection[T]
+}
+
+// Returns a read only view of c. Methods that modify the collection panic, including Remove() and Set() of its iterators.
+// Changes made to c directly are visible through the view
+func UnmodifiableCollection[T any](c coll.Collection[T]) coll.Collection[T] {
+	return &unmodifiableCollection[T]{c}
+}
+
+// Returns a read only view of s. See UnmodifiableCollection
+func UnmodifiableSet[T comparable](s set.Set[T]) set.Set[T] {
+	return &unmodifiableCollection[T]{s}
+}
+
+func (v *unmodifiableCollection[T]) ForEach(visitor coll.Visitor[T]) int {
+	return v.c.ForEach(visitor)
+}
+
+func (v *unmodifiableCollection[T]) Add(element T) bool {
+	unsupported()
+	return false
+}
+
+func (v *unmodifiableCollection[T]) AddAll(elements coll.Collection[T]) int {
+	unsupported()
+	return 0
+}
+
+func (v *unmodifiableCollection[T]) ContainsFunc(what T, equals coll.Equalizer[T]) bool {
+	return v.c.ContainsFunc(what, equals)
+}
+
+func (v *unmodifiableCollection[T]) Contains(data T) bool {
+	return v.c.Contains(data)
+}
+
+func (v *unmodifiableCollection[T]) IsEmpty() bool {
+	return v.c.IsEmpty()
+}
+
+func (v *unmodifiableCollection[T]) Iterator() coll.Iterator[T] {
+	return &unmodifiableIterator[T]{v.c.Iterator()}
+}
+
+func (v *unmodifiableCollection[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	unsupported()
+	return 0
+}
+
+func (v *unmodifiableCollection[T]) RemoveAll(collection coll.Collection[T]) int {
+	unsupported()
+	return 0
+}
+
+func (v *unmodifiableCollection[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	unsupported()
+	return 0
+}
+
+func (v *unmodifiableCollection[T]) RetainAll(collection coll.Collection[T]) int {
+	unsupported()
+	return 0
+}
+
+func (v *unmodifiableCollection[T]) Size() int {
+	return v.c.Size()
+}
+
+func (v *unmodifiableCollection[T]) ToArray() []T {
+	return v.c.ToArray()
+}
+
+func (v *unmodifiableCollection[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *unmodifiableCollection[T]) Clear() int {
+	unsupported()
+	return 0
+}
+
+type unmodifiableIterator[T any] struct {
+	iter coll.Iterator[T]
+}
+
+func (v *unmodifiableIterator[T]) Next() (T, bool) {
+	return v.iter.Next()
+}
+
+func (v *unmodifiableIterator[T]) Remove() {
+	unsupported()
+}
+
+func (v *unmodifiableIterator[T]) Set(data T) T {
+	unsupported()
+	return data
+}
+
+type unmodifiableList[T any] struct {
+	unmodifiableCollection[T]
+	l list.List[T]
+}
+
+// Returns a read only view of l. See UnmodifiableCollection.
+// Copy(), CopySubList() and Reverse() return new lists that can be modified
+func UnmodifiableList[T any](l list.List[T]) list.List[T] {
+	return &unmodifiableList[T]{unmodifiableCollection[T]{l}, l}
+}
+
+func (v *unmodifiableList[T]) AddAt(index int, element T) bool {
+	unsupported()
+	return false
+}
+
+func (v *unmodifiableList[T]) AddAllAt(index int, elements coll.Collection[T]) int {
+	unsupported()
+	return 0
+}
+
+func (v *unmodifiableList[T]) Get(index int) T {
+	return v.l.Get(index)
+}
+
+func (v *unmodifiableList[T]) IndexOfFunc(what T, equals coll.Equalizer[T]) int {
+	return v.l.IndexOfFunc(what, equals)
+}
+
+func (v *unmodifiableList[T]) IndexOf(what T) int {
+	return v.l.IndexOf(what)
+}
+
+func (v *unmodifiableList[T]) LastIndexOfFunc(what T, equals coll.Equalizer[T]) int {
+	return v.l.LastIndexOfFunc(what, equals)
+}
+
+func (v *unmodifiableList[T]) LastIndexOf(what T) int {
+	return v.l.LastIndexOf(what)
+}
+
+func (v *unmodifiableList[T]) Set(index int, newValue T) T {
+	unsupported()
+	return newValue
+}
+
+func (v *unmodifiableList[T]) RemoveFirstFunc(data T, equals coll.Equalizer[T]) bool {
+	unsupported()
+	return false
+}
+
+func (v *unmodifiableList[T]) RemoveFirst(data T) bool {
+	unsupported()
+	return false
+}
+
+func (v *unmodifiableList[T]) RemoveAt(index int) T {
+	unsupported()
+	var zv T
+	return zv
+}
+
+func (v *unmodifiableList[T]) CopySubList(fromIndexIncluded int, endIndexExcluded int) list.List[T] {
+	return v.l.CopySubList(fromIndexIncluded, endIndexExcluded)
+}
+
+func (v *unmodifiableList[T]) SubList(fromIndexIncluded int, endIndexExcluded int) list.List[T] {
+	return UnmodifiableList(v.l.SubList(fromIndexIncluded, endIndexExcluded))
+}
+
+func (v *unmodifiableList[T]) Copy() list.List[T] {
+	return v.l.Copy()
+}
+
+func (v *unmodifiableList[T]) Reverse() list.List[T] {
+	return v.l.Reverse()
+}
+
+func (v *unmodifiableList[T]) Sort(comparator coll.Comparator[T]) {
+	unsupported()
+}
+
+func (v *unmodifiableList[T]) ListIterator() list.ListIterator[T] {
+	return &unmodifiableListIterator[T]{v.l.ListIterator()}
+}
+
+func (v *unmodifiableList[T]) ListIteratorAt(index int) list.ListIterator[T] {
+	return &unmodifiableListIterator[T]{v.l.ListIteratorAt(index)}
+}
+
+type unmodifiableListIterator[T any] struct {
+	iter list.ListIterator[T]
+}
+
+func (v *unmodifiableListIterator[T]) Next() (T, bool) {
+	return v.iter.Next()
+}
+
+func (v *unmodifiableListIterator[T]) HasNext() bool {
+	return v.iter.HasNext()
+}
+
+func (v *unmodifiableListIterator[T]) HasPrevious() bool {
+	return v.iter.HasPrevious()
+}
+
+func (v *unmodifiableListIterator[T]) Previous() (T, bool) {
+	return v.iter.Previous()
+}
+
+func (v *unmodifiableListIterator[T]) NextIndex() int {
+	return v.iter.NextIndex()
+}
+
+func (v *unmodifiableListIterator[T]) PreviousIndex() int {
+	return v.iter.PreviousIndex()
+}
+
+func (v *unmodifiableListIterator[T]) Remove() {
+	unsupported()
+}
+
+func (v *unmodifiableListIterator[T]) Set(data T) T {
+	unsupported()
+	return data
+}
+
+func (v *unmodifiableListIterator[T]) Add(data T) {
+	unsupported()
+}
+
+type unmodifiableMap[K comparable, V any] struct {
+	m mp.Map[K, V]
+}
+
+// Returns a read only view of m. Methods that modify the map panic, including Remove() and Set() of its iterators.
+// Keys() and Values() are read only too
+func UnmodifiableMap[K comparable, V any](m mp.Map[K, V]) mp.Map[K, V] {
+	return &unmodifiableMap[K, V]{m}
+}
+
+func (v *unmodifiableMap[K, V]) Size() int {
+	return v.m.Size()
+}
+
+func (v *unmodifiableMap[K, V]) Contains(key K) bool {
+	return v.m.Contains(key)
+}
+
+func (v *unmodifiableMap[K, V]) Get(key K) (V, bool) {
+	return v.m.Get(key)
+}
+
+func (v *unmodifiableMap[K, V]) Put(key K, value V) {
+	unsupported()
+}
+
+func (v *unmodifiableMap[K, V]) PutAll(other mp.Map[K, V]) {
+	unsupported()
+}
+
+func (v *unmodifiableMap[K, V]) Remove(key K) (result V, removed bool) {
+	unsupported()
+	return
+}
+
+func (v *unmodifiableMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	return v.m.GetOrDefault(key, defaultValue)
+}
+
+func (v *unmodifiableMap[K, V]) PutIfAbsent(key K, value V) (result V, present bool) {
+	unsupported()
+	return
+}
+
+func (v *unmodifiableMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) (result V) {
+	unsupported()
+	return
+}
+
+func (v *unmodifiableMap[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	unsupported()
+	return
+}
+
+func (v *unmodifiableMap[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	unsupported()
+	return
+}
+
+func (v *unmodifiableMap[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	unsupported()
+	return
+}
+
+func (v *unmodifiableMap[K, V]) Replace(key K, value V) (result V, replaced bool) {
+	unsupported()
+	return
+}
+
+func (v *unmodifiableMap[K, V]) ReplaceAll(function func(key K, value V) V) {
+	unsupported()
+}
+
+func (v *unmodifiableMap[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	return v.m.ForEach(visitor)
+}
+
+func (v *unmodifiableMap[K, V]) RemoveAll(keys coll.Collection[K]) {
+	unsupported()
+}
+
+func (v *unmodifiableMap[K, V]) ContainsValueFunc(value V, equals coll.Equalizer[V]) bool {
+	return v.m.ContainsValueFunc(value, equals)
+}
+
+func (v *unmodifiableMap[K, V]) ContainsValue(value V) bool {
+	return v.m.ContainsValue(value)
+}
+
+func (v *unmodifiableMap[K, V]) Iterator() coll.Iterator[mp.KV[K, V]] {
+	return &unmodifiableIterator[mp.KV[K, V]]{v.m.Iterator()}
+}
+
+func (v *unmodifiableMap[K, V]) Keys() set.Set[K] {
+	return UnmodifiableSet(v.m.Keys())
+}
+
+func (v *unmodifiableMap[K, V]) Values() coll.Collection[V] {
+	return UnmodifiableCollection(v.m.Values())
+}
+
+// Live views that see changes to the map, but can't change it
+func (v *unmodifiableMap[K, V]) KeySet() set.Set[K] {
+	return mp.KeySet[K, V](v)
+}
+
+func (v *unmodifiableMap[K, V]) ValuesView() coll.Collection[V] {
+	return mp.ValuesView[K, V](v)
+}
+
+func (v *unmodifiableMap[K, V]) EntrySet() coll.Collection[mp.Entry[K, V]] {
+	return mp.EntrySet[K, V](v)
+}
+
+func (v *unmodifiableMap[K, V]) Stream() stream.Stream[mp.KV[K, V]] {
+	return stream.FromIterator[mp.KV[K, V]](v.Iterator())
+}
@@ -0,0 +1,129 @@
+package Collections
+
+import (
+	"sync"
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	mp "github.com/wushilin/gojava/Map"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/gojava/common"
+)
+
+func assertPanics(t *testing.T, f func()) {
+	defer func() {
+		common.AssertTrue(t, recover() != nil)
+	}()
+	f()
+	t.Fatal("Expect panic")
+}
+
+func TestUnmodifiable(t *testing.T) {
+	backing := list.ArrayListOf(1, 2, 3)
+	l := UnmodifiableList[int](backing)
+	assertPanics(t, func() { l.Add(4) })
+	assertPanics(t, func() { l.Set(0, 4) })
+	assertPanics(t, func() { l.Clear() })
+	assertPanics(t, func() { l.SubList(0, 1).RemoveAt(0) })
+	iter := l.Iterator()
+	iter.Next()
+	assertPanics(t, func() { iter.Remove() })
+	listIter := l.ListIterator()
+	assertPanics(t, func() { listIter.Add(0) })
+
+	// It is a view, not a copy
+	backing.Add(4)
+	common.AssertEq(t, l.Size(), 4)
+	common.AssertEq(t, l.Get(3), 4)
+	copied := l.Copy()
+	copied.Add(5)
+	common.AssertEq(t, copied.Size(), 5)
+
+	s := UnmodifiableSet[int](set.HashSetOf(1, 2))
+	common.AssertTrue(t, s.Contains(1))
+	assertPanics(t, func() { s.Add(3) })
+
+	m := mp.NewHashMap[string, int]()
+	m.Put("a", 1)
+	um := UnmodifiableMap[string, int](m)
+	value, _ := um.Get("a")
+	common.AssertEq(t, value, 1)
+	assertPanics(t, func() { um.Put("b", 2) })
+	assertPanics(t, func() { um.ComputeIfAbsent("b", func(string) int { return 2 }) })
+	common.AssertEq(t, um.GetOrDefault("b", 2), 2)
+	assertPanics(t, func() { um.Keys().Clear() })
+	entries := um.Iterator()
+	entries.Next()
+	assertPanics(t, func() { entries.Set(mp.KVOf("a", 3)) })
+}
+
+func TestSynchronized(t *testing.T) {
+	l := SynchronizedList[int](list.NewArrayList[int]())
+	m := SynchronizedMap[int, int](mp.NewHashMap[int, int]())
+	s := SynchronizedSet[int](set.NewHashSet[int]())
+	counts := SynchronizedMap[int, int](mp.NewHashMap[int, int]())
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				l.Add(i)
+				m.Put(g*500+i, i)
+				s.Add(i)
+				counts.Merge(i%10, 1, func(old, one int) (int, bool) { return old + one, true })
+				l.ForEach(func(i int) bool {
+					return i < 10
+				})
+			}
+		}(g)
+	}
+	wg.Wait()
+	common.AssertEq(t, l.Size(), 4000)
+	common.AssertEq(t, m.Size(), 4000)
+	common.AssertEq(t, s.Size(), 500)
+	common.AssertEq(t, counts.GetOrDefault(3, 0), 400)
+	view := l.SubList(0, 10)
+	view.Clear()
+	common.AssertEq(t, l.Size(), 3990)
+}
+
+func TestSynchronizedReentry(t *testing.T) {
+	l := SynchronizedList[int](list.ArrayListOf(1, 2))
+	common.AssertEq(t, l.AddAll(l), 2)
+	common.AssertEq(t, l.AddAllAt(0, l), 4)
+	common.AssertEq(t, l.Size(), 8)
+	sizes := 0
+	l.ForEach(func(i int) bool {
+		sizes += l.Size()
+		l.Add(i)
+		return true
+	})
+	common.AssertEq(t, sizes, 8*8+28)
+	common.AssertEq(t, l.RetainAll(l), 0)
+	common.AssertEq(t, l.RemoveAll(l), 16)
+	common.AssertTrue(t, l.IsEmpty())
+
+	s := SynchronizedSet[int](set.HashSetOf(1, 2))
+	common.AssertEq(t, s.AddAll(s), 0)
+	common.AssertEq(t, s.RemoveAllFunc(s, coll.DefaultEqualizer[int]()), 2)
+
+	sizeEquals := func(a, b int) bool {
+		return l.Size() >= 0 && a == b
+	}
+	l.Add(1)
+	common.AssertTrue(t, l.ContainsFunc(1, sizeEquals))
+	common.AssertEq(t, l.IndexOfFunc(1, sizeEquals), 0)
+	common.AssertEq(t, l.LastIndexOfFunc(1, sizeEquals), 0)
+	l.Clear()
+
+	m := SynchronizedMap[int, int](mp.NewHashMap[int, int]())
+	m.Put(1, 1)
+	common.AssertTrue(t, m.ContainsValueFunc(1, func(a, b int) bool {
+		return m.Size() > 0 && a == b
+	}))
+	m.PutAll(m)
+	m.RemoveAll(m.KeySet())
+	common.AssertEq(t, m.Size(), 0)
+}
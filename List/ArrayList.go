@@ -148,6 +148,24 @@ func (v *ArrayList[T]) CopySubList(startInclude int, endExclude int) (newList Li
 	return result
 }
 
+func (v *ArrayList[T]) SubList(startInclude int, endExclude int) List[T] {
+	subListRangeCheck(startInclude, endExclude, v.length)
+	return newSubListView[T](v, nil, 0, startInclude, endExclude)
+}
+
+func (v *ArrayList[T]) modCount() int {
+	return v.generation
+}
+
+func (v *ArrayList[T]) removeRange(startInclude int, endExclude int) {
+	if startInclude == endExclude {
+		return
+	}
+	defer v.applyMod()
+	v.shiftLeft(endExclude, endExclude-startInclude)
+	v.length -= endExclude - startInclude
+}
+
 func (v *ArrayList[T]) ForEach(visitor coll.Visitor[T]) int {
 	return coll.ForEach(v.Iterator(), visitor)
 }
@@ -161,7 +179,18 @@ func (v *ArrayList[T]) Set(index int, data T) T {
 }
 
 func (v *ArrayList[T]) Iterator() coll.Iterator[T] {
-	return &ArrayListIterator[T]{Src: v, currentIndex: 0, lastReturnedIndex: -1, generation: v.generation}
+	return v.ListIteratorAt(0)
+}
+
+func (v *ArrayList[T]) ListIterator() ListIterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+func (v *ArrayList[T]) ListIteratorAt(index int) ListIterator[T] {
+	if index < 0 || index > v.length {
+		panic("Index Out of Bound")
+	}
+	return &ArrayListIterator[T]{Src: v, currentIndex: index, lastReturnedIndex: -1, generation: v.generation}
 }
 
 func (v *ArrayList[T]) IndexOf(data T) int {
@@ -225,6 +254,13 @@ func (v *ArrayList[T]) Reverse() List[T] {
 	return &ArrayList[T]{buffer: newdata, length: v.length, generation: 0}
 
 }
+
+// Stable merge sort directly on the backing buffer
+func (v *ArrayList[T]) Sort(comparator coll.Comparator[T]) {
+	defer v.applyMod()
+	mergeSort(v.buffer[:v.length], comparator)
+}
+
 func (v *ArrayList[T]) RemoveFirstFunc(data T, equals coll.Equalizer[T]) bool {
 	return RemoveFirstFunc(v.Iterator(), data, equals)
 }
@@ -269,16 +305,56 @@ func (v *ArrayListIterator[T]) Next() (val T, ok bool) {
 	return result, true
 }
 
+func (v *ArrayListIterator[T]) HasNext() bool {
+	v.checkMod()
+	return v.currentIndex < v.Src.Size()
+}
+
+func (v *ArrayListIterator[T]) HasPrevious() bool {
+	v.checkMod()
+	return v.currentIndex > 0
+}
+
+func (v *ArrayListIterator[T]) Previous() (val T, ok bool) {
+	v.checkMod()
+	if v.currentIndex <= 0 {
+		var zv T
+		return zv, false
+	}
+	v.currentIndex--
+	v.lastReturnedIndex = v.currentIndex
+	return v.Src.Get(v.currentIndex), true
+}
+
+func (v *ArrayListIterator[T]) NextIndex() int {
+	return v.currentIndex
+}
+
+func (v *ArrayListIterator[T]) PreviousIndex() int {
+	return v.currentIndex - 1
+}
+
 func (v *ArrayListIterator[T]) Remove() {
 	v.checkMod()
-	if v.lastReturnedIndex == -1 || v.lastReturnedIndex == v.currentIndex {
+	if v.lastReturnedIndex == -1 {
 		panic("Don't call Remove when you have not read, or you have removed")
 	}
 	defer v.applyMod()
 	v.Src.shiftLeft(v.lastReturnedIndex+1, 1)
 	v.Src.length--
+	if v.lastReturnedIndex < v.currentIndex {
+		// Returned by Next(), the cursor moves back with the elements
+		v.currentIndex--
+	}
+	v.lastReturnedIndex = -1
+}
+
+func (v *ArrayListIterator[T]) Add(data T) {
+	v.checkMod()
+	v.Src.AddAt(v.currentIndex, data)
+	v.generation = v.Src.generation
+	v.currentIndex++
 	v.lastReturnedIndex = -1
-	v.currentIndex--
 }
 
 func (v *ArrayListIterator[T]) Set(data T) T {
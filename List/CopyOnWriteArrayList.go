@@ -0,0 +1,417 @@
+package List
+
+import (
+	"sync"
+	"sync/atomic"
+
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/stream"
+)
+
+// A list that is safe for concurrent use. Every modification copies the backing array, so reads never lock
+// and iterators see the snapshot taken when they were created.
+// Good for read mostly data, like listener registries. Writes are O(n)
+type CopyOnWriteArrayList[T any] struct {
+	lock       sync.Mutex
+	data       atomic.Value
+	generation int64
+}
+
+// Current snapshot. It must not be modified
+func (v *CopyOnWriteArrayList[T]) array() []T {
+	result, _ := v.data.Load().([]T)
+	return result
+}
+
+// Apply update to a copy of the current array and publish the result. update returns the new array
+func (v *CopyOnWriteArrayList[T]) mutate(update func(current []T) []T) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.data.Store(update(v.array()))
+	atomic.AddInt64(&v.generation, 1)
+}
+
+func (v *CopyOnWriteArrayList[T]) modCount() int {
+	return int(atomic.LoadInt64(&v.generation))
+}
+
+func (v *CopyOnWriteArrayList[T]) removeRange(fromIndexIncluded int, endIndexExcluded int) {
+	v.mutate(func(current []T) []T {
+		subListRangeCheck(fromIndexIncluded, endIndexExcluded, len(current))
+		result := make([]T, 0, len(current)-(endIndexExcluded-fromIndexIncluded))
+		result = append(result, current[:fromIndexIncluded]...)
+		return append(result, current[endIndexExcluded:]...)
+	})
+}
+
+func indexCheck(index int, length int) {
+	if index < 0 || index >= length {
+		panic("Index Out of Bound")
+	}
+}
+
+func (v *CopyOnWriteArrayList[T]) Size() int {
+	return len(v.array())
+}
+
+func (v *CopyOnWriteArrayList[T]) IsEmpty() bool {
+	return v.Size() == 0
+}
+
+func (v *CopyOnWriteArrayList[T]) Get(index int) T {
+	current := v.array()
+	indexCheck(index, len(current))
+	return current[index]
+}
+
+func (v *CopyOnWriteArrayList[T]) Add(element T) bool {
+	v.mutate(func(current []T) []T {
+		return append(copyArray(current), element)
+	})
+	return true
+}
+
+func (v *CopyOnWriteArrayList[T]) AddAt(index int, element T) (added bool) {
+	v.mutate(func(current []T) []T {
+		if index < 0 || index > len(current) {
+			panic("Index Out of Bound")
+		}
+		result := make([]T, len(current)+1)
+		copy(result, current[:index])
+		result[index] = element
+		copy(result[index+1:], current[index:])
+		return result
+	})
+	return true
+}
+
+func (v *CopyOnWriteArrayList[T]) AddAll(elements coll.Collection[T]) int {
+	toAdd := elements.ToArray()
+	v.mutate(func(current []T) []T {
+		return append(copyArray(current), toAdd...)
+	})
+	return len(toAdd)
+}
+
+func (v *CopyOnWriteArrayList[T]) AddAllAt(index int, elements coll.Collection[T]) int {
+	toAdd := elements.ToArray()
+	v.mutate(func(current []T) []T {
+		if index < 0 || index > len(current) {
+			panic("Index Out of Bound")
+		}
+		result := make([]T, 0, len(current)+len(toAdd))
+		result = append(result, current[:index]...)
+		result = append(result, toAdd...)
+		return append(result, current[index:]...)
+	})
+	return len(toAdd)
+}
+
+// Add element if it is not in the list (compared with the default equalizer). Returns whether it was added
+func (v *CopyOnWriteArrayList[T]) AddIfAbsent(element T) bool {
+	return v.AddIfAbsentFunc(element, coll.DefaultEqualizer[T]())
+}
+
+// Same as AddIfAbsent, but compares elements with equals
+func (v *CopyOnWriteArrayList[T]) AddIfAbsentFunc(element T, equals coll.Equalizer[T]) bool {
+	added := false
+	v.mutate(func(current []T) []T {
+		if indexIn(current, element, equals) != -1 {
+			return current
+		}
+		added = true
+		return append(copyArray(current), element)
+	})
+	return added
+}
+
+// Add the elements that are not in the list yet, in order, skipping duplicates in elements too.
+// Returns the number of elements added
+func (v *CopyOnWriteArrayList[T]) AddAllAbsent(elements coll.Collection[T]) int {
+	return v.AddAllAbsentFunc(elements, coll.DefaultEqualizer[T]())
+}
+
+// Same as AddAllAbsent, but compares elements with equals
+func (v *CopyOnWriteArrayList[T]) AddAllAbsentFunc(elements coll.Collection[T], equals coll.Equalizer[T]) int {
+	toAdd := elements.ToArray()
+	count := 0
+	v.mutate(func(current []T) []T {
+		result := copyArray(current)
+		for _, next := range toAdd {
+			if indexIn(result, next, equals) == -1 {
+				result = append(result, next)
+				count++
+			}
+		}
+		return result
+	})
+	return count
+}
+
+func (v *CopyOnWriteArrayList[T]) Set(index int, newValue T) (oldValue T) {
+	v.mutate(func(current []T) []T {
+		indexCheck(index, len(current))
+		result := copyArray(current)
+		oldValue = result[index]
+		result[index] = newValue
+		return result
+	})
+	return
+}
+
+// Calls update with a copy of the array and publishes it as one modification, so readers never see it half done.
+// update can overwrite and rearrange the elements
+func (v *CopyOnWriteArrayList[T]) Update(update func(elements []T)) {
+	v.mutate(func(current []T) []T {
+		result := copyArray(current)
+		update(result)
+		return result
+	})
+}
+
+func (v *CopyOnWriteArrayList[T]) RemoveAt(index int) (removed T) {
+	v.mutate(func(current []T) []T {
+		indexCheck(index, len(current))
+		removed = current[index]
+		return removeIndex(current, index)
+	})
+	return
+}
+
+func (v *CopyOnWriteArrayList[T]) RemoveFirst(data T) bool {
+	return v.RemoveFirstFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *CopyOnWriteArrayList[T]) RemoveFirstFunc(data T, equals coll.Equalizer[T]) bool {
+	removed := false
+	v.mutate(func(current []T) []T {
+		index := indexIn(current, data, equals)
+		if index == -1 {
+			return current
+		}
+		removed = true
+		return removeIndex(current, index)
+	})
+	return removed
+}
+
+// Keep only the elements for which keep returns true. Returns the number of elements removed
+func (v *CopyOnWriteArrayList[T]) filter(keep func(T) bool) int {
+	count := 0
+	v.mutate(func(current []T) []T {
+		result := make([]T, 0, len(current))
+		for _, next := range current {
+			if keep(next) {
+				result = append(result, next)
+			}
+		}
+		count = len(current) - len(result)
+		if count == 0 {
+			return current
+		}
+		return result
+	})
+	return count
+}
+
+func (v *CopyOnWriteArrayList[T]) RemoveAll(collection coll.Collection[T]) int {
+	return v.RemoveAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *CopyOnWriteArrayList[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.filter(func(i T) bool {
+		return !collection.ContainsFunc(i, equals)
+	})
+}
+
+func (v *CopyOnWriteArrayList[T]) RetainAll(collection coll.Collection[T]) int {
+	return v.RetainAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *CopyOnWriteArrayList[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.filter(func(i T) bool {
+		return collection.ContainsFunc(i, equals)
+	})
+}
+
+func (v *CopyOnWriteArrayList[T]) Clear() int {
+	count := 0
+	v.mutate(func(current []T) []T {
+		count = len(current)
+		return []T{}
+	})
+	return count
+}
+
+func (v *CopyOnWriteArrayList[T]) Contains(data T) bool {
+	return v.ContainsFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *CopyOnWriteArrayList[T]) ContainsFunc(data T, equals coll.Equalizer[T]) bool {
+	return indexIn(v.array(), data, equals) != -1
+}
+
+func (v *CopyOnWriteArrayList[T]) IndexOf(data T) int {
+	return v.IndexOfFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *CopyOnWriteArrayList[T]) IndexOfFunc(data T, equals coll.Equalizer[T]) int {
+	return indexIn(v.array(), data, equals)
+}
+
+func (v *CopyOnWriteArrayList[T]) LastIndexOf(data T) int {
+	return v.LastIndexOfFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *CopyOnWriteArrayList[T]) LastIndexOfFunc(data T, equals coll.Equalizer[T]) int {
+	current := v.array()
+	for i := len(current) - 1; i >= 0; i-- {
+		if equals(current[i], data) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (v *CopyOnWriteArrayList[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+// Returns an iterator over the current snapshot. Modifying the list doesn't affect the iterator, and
+// the iterator doesn't modify the list: Remove() and Set() panic
+func (v *CopyOnWriteArrayList[T]) Iterator() coll.Iterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+// Same as Iterator(), Add() panics too
+func (v *CopyOnWriteArrayList[T]) ListIterator() ListIterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+func (v *CopyOnWriteArrayList[T]) ListIteratorAt(index int) ListIterator[T] {
+	snapshot := v.array()
+	if index < 0 || index > len(snapshot) {
+		panic("Index Out of Bound")
+	}
+	return &CopyOnWriteArrayListIterator[T]{snapshot: snapshot, currentIndex: index}
+}
+
+func (v *CopyOnWriteArrayList[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *CopyOnWriteArrayList[T]) ToArray() []T {
+	return copyArray(v.array())
+}
+
+func (v *CopyOnWriteArrayList[T]) Copy() List[T] {
+	return CopyOnWriteArrayListOf(v.array()...)
+}
+
+func (v *CopyOnWriteArrayList[T]) CopySubList(fromIndexIncluded int, endIndexExcluded int) List[T] {
+	current := v.array()
+	if fromIndexIncluded < 0 || endIndexExcluded > len(current) || fromIndexIncluded > endIndexExcluded {
+		panic("Index Out of Bound")
+	}
+	return CopyOnWriteArrayListOf(current[fromIndexIncluded:endIndexExcluded]...)
+}
+
+// Returns a live view of the list. The view is not safe for concurrent use, and it panics once
+// the list is modified other than through the view. Its iterators don't support Remove(), Set() and Add()
+func (v *CopyOnWriteArrayList[T]) SubList(fromIndexIncluded int, endIndexExcluded int) List[T] {
+	subListRangeCheck(fromIndexIncluded, endIndexExcluded, v.Size())
+	return newSubListView[T](v, nil, 0, fromIndexIncluded, endIndexExcluded)
+}
+
+func (v *CopyOnWriteArrayList[T]) Reverse() List[T] {
+	result := NewCopyOnWriteArrayList[T]()
+	result.data.Store(reverseCopyArray(v.array()))
+	return result
+}
+
+// Sorts a copy of the array and publishes it. Iterators created before keep the old order
+func (v *CopyOnWriteArrayList[T]) Sort(comparator coll.Comparator[T]) {
+	v.mutate(func(current []T) []T {
+		result := copyArray(current)
+		mergeSort(result, comparator)
+		return result
+	})
+}
+
+func indexIn[T any](what []T, data T, equals coll.Equalizer[T]) int {
+	for index, next := range what {
+		if equals(next, data) {
+			return index
+		}
+	}
+	return -1
+}
+
+func removeIndex[T any](what []T, index int) []T {
+	result := make([]T, 0, len(what)-1)
+	result = append(result, what[:index]...)
+	return append(result, what[index+1:]...)
+}
+
+type CopyOnWriteArrayListIterator[T any] struct {
+	snapshot     []T
+	currentIndex int
+}
+
+func (v *CopyOnWriteArrayListIterator[T]) Next() (result T, ok bool) {
+	if v.currentIndex >= len(v.snapshot) {
+		return result, false
+	}
+	result = v.snapshot[v.currentIndex]
+	v.currentIndex++
+	return result, true
+}
+
+func (v *CopyOnWriteArrayListIterator[T]) HasNext() bool {
+	return v.currentIndex < len(v.snapshot)
+}
+
+func (v *CopyOnWriteArrayListIterator[T]) HasPrevious() bool {
+	return v.currentIndex > 0
+}
+
+func (v *CopyOnWriteArrayListIterator[T]) Previous() (result T, ok bool) {
+	if v.currentIndex <= 0 {
+		return result, false
+	}
+	v.currentIndex--
+	return v.snapshot[v.currentIndex], true
+}
+
+func (v *CopyOnWriteArrayListIterator[T]) NextIndex() int {
+	return v.currentIndex
+}
+
+func (v *CopyOnWriteArrayListIterator[T]) PreviousIndex() int {
+	return v.currentIndex - 1
+}
+
+func (v *CopyOnWriteArrayListIterator[T]) Add(data T) {
+	panic("CopyOnWriteArrayList iterator doesn't support Add")
+}
+
+func (v *CopyOnWriteArrayListIterator[T]) Remove() {
+	panic("CopyOnWriteArrayList iterator doesn't support Remove")
+}
+
+func (v *CopyOnWriteArrayListIterator[T]) Set(data T) T {
+	panic("CopyOnWriteArrayList iterator doesn't support Set")
+}
+
+// Return new empty CopyOnWriteArrayList[T]
+func NewCopyOnWriteArrayList[T any]() *CopyOnWriteArrayList[T] {
+	result := &CopyOnWriteArrayList[T]{}
+	result.data.Store([]T{})
+	return result
+}
+
+func CopyOnWriteArrayListOf[T any](arg ...T) *CopyOnWriteArrayList[T] {
+	result := NewCopyOnWriteArrayList[T]()
+	result.data.Store(copyArray(arg))
+	return result
+}
@@ -0,0 +1,104 @@
+package List
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+var _ List[int] = NewCopyOnWriteArrayList[int]()
+
+func TestCopyOnWriteArrayList(t *testing.T) {
+	list := NewCopyOnWriteArrayList[int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				list.Add(g*100 + i)
+				list.AddIfAbsent(-i - 1)
+				// Iterating while other goroutines write must not panic
+				sum := 0
+				list.ForEach(func(i int) bool {
+					sum += i
+					return true
+				})
+			}
+		}(g)
+	}
+	wg.Wait()
+	common.AssertEq(t, list.Size(), 900)
+	common.AssertEq(t, list.IndexOf(-100), list.LastIndexOf(-100))
+
+	// Add must append to whatever the list holds at the time, even while other goroutines shrink it
+	list = NewCopyOnWriteArrayList[int]()
+	stop := make(chan bool)
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			list.Clear()
+			func() {
+				// The list may be empty again by now, that panic is expected
+				defer func() { recover() }()
+				list.RemoveAt(0)
+			}()
+		}
+	}()
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 10000; i++ {
+				list.Add(i)
+			}
+		}()
+	}
+	wg.Wait()
+	close(stop)
+	<-done
+
+	list = CopyOnWriteArrayListOf(1, 2, 3)
+	iter := list.Iterator()
+	list.AddAt(0, 0)
+	list.RemoveAt(3)
+	common.AssertArrEq(t, list.ToArray(), []int{0, 1, 2})
+	common.AssertArrEq(t, CopyOnWriteArrayListOf(1, 2, 3).ToArray(), []int{1, 2, 3})
+	snapshot := []int{}
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		snapshot = append(snapshot, next)
+	}
+	common.AssertArrEq(t, snapshot, []int{1, 2, 3})
+
+	common.AssertFalse(t, list.AddIfAbsent(1))
+	common.AssertEq(t, list.AddAllAbsent(ArrayListOf(2, 3, 4, 3)), 2)
+	common.AssertArrEq(t, list.ToArray(), []int{0, 1, 2, 3, 4})
+	common.AssertEq(t, list.Set(0, 10), 0)
+	common.AssertTrue(t, list.RemoveFirst(10))
+	common.AssertFalse(t, list.RemoveFirst(10))
+	common.AssertEq(t, list.AddAllAt(1, ArrayListOf(7, 8)), 2)
+	common.AssertArrEq(t, list.ToArray(), []int{1, 7, 8, 2, 3, 4})
+	common.AssertEq(t, list.RemoveAll(ArrayListOf(7, 8)), 2)
+	common.AssertEq(t, list.RetainAll(ArrayListOf(1, 2)), 2)
+	common.AssertArrEq(t, list.Reverse().ToArray(), []int{2, 1})
+	iter = list.Iterator()
+	list.Update(func(elements []int) {
+		elements[0], elements[1] = elements[1], elements[0]
+	})
+	common.AssertArrEq(t, list.ToArray(), []int{2, 1})
+	next, _ := iter.Next()
+	common.AssertEq(t, next, 1)
+	common.AssertEq(t, list.Clear(), 2)
+	common.AssertTrue(t, list.IsEmpty())
+
+	var zero CopyOnWriteArrayList[int]
+	zero.Add(1)
+	common.AssertEq(t, zero.Get(0), 1)
+}
@@ -0,0 +1,257 @@
+package List
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/stream"
+)
+
+func immutable() {
+	panic("Unsupported operation: collection is immutable")
+}
+
+// The methods of List that modify it, all panic. Embedded by the lists that can't be modified
+type immutableListMutators[T any] struct{}
+
+func (immutableListMutators[T]) Add(element T) bool {
+	immutable()
+	return false
+}
+
+func (immutableListMutators[T]) AddAt(index int, element T) bool {
+	immutable()
+	return false
+}
+
+func (immutableListMutators[T]) AddAll(elements coll.Collection[T]) int {
+	immutable()
+	return 0
+}
+
+func (immutableListMutators[T]) AddAllAt(index int, elements coll.Collection[T]) int {
+	immutable()
+	return 0
+}
+
+func (immutableListMutators[T]) Set(index int, newValue T) T {
+	immutable()
+	return newValue
+}
+
+func (immutableListMutators[T]) RemoveAt(index int) T {
+	immutable()
+	var zv T
+	return zv
+}
+
+func (immutableListMutators[T]) RemoveFirst(data T) bool {
+	immutable()
+	return false
+}
+
+func (immutableListMutators[T]) RemoveFirstFunc(data T, equals coll.Equalizer[T]) bool {
+	immutable()
+	return false
+}
+
+func (immutableListMutators[T]) RemoveAll(collection coll.Collection[T]) int {
+	immutable()
+	return 0
+}
+
+func (immutableListMutators[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	immutable()
+	return 0
+}
+
+func (immutableListMutators[T]) RetainAll(collection coll.Collection[T]) int {
+	immutable()
+	return 0
+}
+
+func (immutableListMutators[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	immutable()
+	return 0
+}
+
+func (immutableListMutators[T]) Clear() int {
+	immutable()
+	return 0
+}
+
+func (immutableListMutators[T]) Sort(comparator coll.Comparator[T]) {
+	immutable()
+}
+
+// A List that can't be modified after it is created. Methods that modify it panic, including
+// Remove(), Set() and Add() of its iterators. It is safe to share across goroutines.
+// Copy(), CopySubList() and Reverse() return new ArrayLists that can be modified
+type ImmutableList[T any] struct {
+	immutableListMutators[T]
+	data []T
+}
+
+// Return an ImmutableList with the given elements
+func ListOf[T any](args ...T) *ImmutableList[T] {
+	return &ImmutableList[T]{data: copyArray(args)}
+}
+
+func (v *ImmutableList[T]) Size() int {
+	return len(v.data)
+}
+
+func (v *ImmutableList[T]) IsEmpty() bool {
+	return len(v.data) == 0
+}
+
+func (v *ImmutableList[T]) Get(index int) T {
+	indexCheck(index, len(v.data))
+	return v.data[index]
+}
+
+func (v *ImmutableList[T]) Contains(data T) bool {
+	return v.ContainsFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *ImmutableList[T]) ContainsFunc(data T, equals coll.Equalizer[T]) bool {
+	return indexIn(v.data, data, equals) != -1
+}
+
+func (v *ImmutableList[T]) IndexOf(data T) int {
+	return v.IndexOfFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *ImmutableList[T]) IndexOfFunc(data T, equals coll.Equalizer[T]) int {
+	return indexIn(v.data, data, equals)
+}
+
+func (v *ImmutableList[T]) LastIndexOf(data T) int {
+	return v.LastIndexOfFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *ImmutableList[T]) LastIndexOfFunc(data T, equals coll.Equalizer[T]) int {
+	for i := len(v.data) - 1; i >= 0; i-- {
+		if equals(v.data[i], data) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (v *ImmutableList[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *ImmutableList[T]) ToArray() []T {
+	return copyArray(v.data)
+}
+
+func (v *ImmutableList[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *ImmutableList[T]) Copy() List[T] {
+	return ArrayListOf(v.data...)
+}
+
+func (v *ImmutableList[T]) CopySubList(fromIndexIncluded int, endIndexExcluded int) List[T] {
+	subListRangeCheck(fromIndexIncluded, endIndexExcluded, len(v.data))
+	return ArrayListOf(v.data[fromIndexIncluded:endIndexExcluded]...)
+}
+
+func (v *ImmutableList[T]) Reverse() List[T] {
+	data := reverseCopyArray(v.data)
+	return &ArrayList[T]{buffer: data, length: len(data)}
+}
+
+// Returns an ImmutableList that shares the elements of this one
+func (v *ImmutableList[T]) SubList(fromIndexIncluded int, endIndexExcluded int) List[T] {
+	subListRangeCheck(fromIndexIncluded, endIndexExcluded, len(v.data))
+	return &ImmutableList[T]{data: v.data[fromIndexIncluded:endIndexExcluded]}
+}
+
+func (v *ImmutableList[T]) Iterator() coll.Iterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+func (v *ImmutableList[T]) ListIterator() ListIterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+func (v *ImmutableList[T]) ListIteratorAt(index int) ListIterator[T] {
+	indexCheck(index, len(v.data)+1)
+	return &ImmutableListIterator[T]{data: v.data, currentIndex: index}
+}
+
+type ImmutableListIterator[T any] struct {
+	data         []T
+	currentIndex int
+}
+
+func (v *ImmutableListIterator[T]) HasNext() bool {
+	return v.currentIndex < len(v.data)
+}
+
+func (v *ImmutableListIterator[T]) HasPrevious() bool {
+	return v.currentIndex > 0
+}
+
+func (v *ImmutableListIterator[T]) Next() (result T, ok bool) {
+	if v.currentIndex >= len(v.data) {
+		return result, false
+	}
+	result = v.data[v.currentIndex]
+	v.currentIndex++
+	return result, true
+}
+
+func (v *ImmutableListIterator[T]) Previous() (result T, ok bool) {
+	if v.currentIndex <= 0 {
+		return result, false
+	}
+	v.currentIndex--
+	return v.data[v.currentIndex], true
+}
+
+func (v *ImmutableListIterator[T]) NextIndex() int {
+	return v.currentIndex
+}
+
+func (v *ImmutableListIterator[T]) PreviousIndex() int {
+	return v.currentIndex - 1
+}
+
+func (v *ImmutableListIterator[T]) Remove() {
+	immutable()
+}
+
+func (v *ImmutableListIterator[T]) Set(data T) T {
+	immutable()
+	return data
+}
+
+func (v *ImmutableListIterator[T]) Add(data T) {
+	immutable()
+}
+
+// Collects elements for an ImmutableList
+type ImmutableListBuilder[T any] struct {
+	data []T
+}
+
+func NewImmutableListBuilder[T any]() *ImmutableListBuilder[T] {
+	return &ImmutableListBuilder[T]{}
+}
+
+func (v *ImmutableListBuilder[T]) Add(elements ...T) *ImmutableListBuilder[T] {
+	v.data = append(v.data, elements...)
+	return v
+}
+
+func (v *ImmutableListBuilder[T]) AddAll(elements coll.Collection[T]) *ImmutableListBuilder[T] {
+	return v.Add(elements.ToArray()...)
+}
+
+// Returns an ImmutableList with the elements added so far. The builder can still be used afterwards
+func (v *ImmutableListBuilder[T]) Build() *ImmutableList[T] {
+	return ListOf(v.data...)
+}
@@ -0,0 +1,41 @@
+package List
+
+import (
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+func assertPanics(t *testing.T, f func()) {
+	defer func() {
+		common.AssertTrue(t, recover() != nil)
+	}()
+	f()
+	t.Fatal("Expect panic")
+}
+
+func TestImmutableList(t *testing.T) {
+	args := []int{1, 2, 3, 2}
+	list := ListOf(args...)
+	args[0] = 10
+	common.AssertEq(t, list.Get(0), 1)
+	common.AssertEq(t, list.LastIndexOf(2), 3)
+	common.AssertArrEq(t, list.SubList(1, 3).ToArray(), []int{2, 3})
+	assertPanics(t, func() { list.Add(4) })
+	assertPanics(t, func() { list.Sort(func(a, b int) int { return a - b }) })
+	assertPanics(t, func() { list.SubList(0, 1).Clear() })
+	iter := list.ListIteratorAt(4)
+	previous, _ := iter.Previous()
+	common.AssertEq(t, previous, 2)
+	assertPanics(t, func() { iter.Remove() })
+
+	copied := list.Copy()
+	copied.Add(5)
+	common.AssertEq(t, list.Size(), 4)
+
+	builder := NewImmutableListBuilder[int]().Add(1, 2).AddAll(ArrayListOf(3))
+	built := builder.Build()
+	builder.Add(4)
+	common.AssertArrEq(t, built.ToArray(), []int{1, 2, 3})
+	common.AssertEq(t, builder.Build().Size(), 4)
+}
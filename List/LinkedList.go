@@ -266,6 +266,28 @@ func (v *LinkedList[T]) CopySubList(start, end int) List[T] {
 	return result
 }
 
+func (v *LinkedList[T]) SubList(start, end int) List[T] {
+	subListRangeCheck(start, end, v.size)
+	return newSubListView[T](v, nil, 0, start, end)
+}
+
+func (v *LinkedList[T]) modCount() int {
+	return v.generation
+}
+
+func (v *LinkedList[T]) removeRange(start, end int) {
+	if start == end {
+		return
+	}
+	defer v.applyMod()
+	node := v.nodeAt(start)
+	for i := start; i < end; i++ {
+		next := node.next
+		v.removeNode(node)
+		node = next
+	}
+}
+
 func (v *LinkedList[T]) Copy() List[T] {
 	return v.CopySubList(0, v.Size())
 }
@@ -279,6 +301,56 @@ func (v *LinkedList[T]) Reverse() List[T] {
 	return result
 }
 
+// Stable merge sort that relinks the nodes instead of copying elements
+func (v *LinkedList[T]) Sort(comparator coll.Comparator[T]) {
+	defer v.applyMod()
+	if v.size < 2 {
+		return
+	}
+	v.head = sortNodes(v.head, v.size, comparator)
+	var prev *linkedListNode[T]
+	for node := v.head; node != nil; node = node.next {
+		node.prev = prev
+		prev = node
+	}
+	v.tail = prev
+}
+
+// Sort the first size nodes starting from head, using only the next links. Returns the new head
+func sortNodes[T any](head *linkedListNode[T], size int, comparator coll.Comparator[T]) *linkedListNode[T] {
+	if size < 2 {
+		head.next = nil
+		return head
+	}
+	middle := head
+	for i := 1; i < size/2; i++ {
+		middle = middle.next
+	}
+	right := middle.next
+	left := sortNodes(head, size/2, comparator)
+	right = sortNodes(right, size-size/2, comparator)
+
+	dummy := &linkedListNode[T]{}
+	tail := dummy
+	for left != nil && right != nil {
+		// Take from the left on ties to keep the sort stable
+		if comparator(left.data, right.data) <= 0 {
+			tail.next = left
+			left = left.next
+		} else {
+			tail.next = right
+			right = right.next
+		}
+		tail = tail.next
+	}
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	return dummy.next
+}
+
 func (v *LinkedList[T]) IsEmpty() bool {
 	return v.size == 0
 }
@@ -288,10 +360,26 @@ type LinkedListIterator[T any] struct {
 	Current    *linkedListNode[T]
 	last       *linkedListNode[T]
 	generation int
+	descending bool
+	// Index of Current, only maintained for ascending iterators
+	index int
 }
 
 func (v *LinkedList[T]) Iterator() coll.Iterator[T] {
-	return &LinkedListIterator[T]{v, v.head, nil, v.generation}
+	return &LinkedListIterator[T]{Src: v, Current: v.head, generation: v.generation}
+}
+
+func (v *LinkedList[T]) ListIterator() ListIterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+func (v *LinkedList[T]) ListIteratorAt(index int) ListIterator[T] {
+	return &LinkedListIterator[T]{Src: v, Current: v.nodeAt(index), generation: v.generation, index: index}
+}
+
+// Returns iterator that walks from tail to head
+func (v *LinkedList[T]) DescendingIterator() coll.Iterator[T] {
+	return &LinkedListIterator[T]{Src: v, Current: v.tail, generation: v.generation, descending: true}
 }
 
 func (v *LinkedListIterator[T]) checkMod() {
@@ -307,14 +395,59 @@ func (v *LinkedListIterator[T]) Next() (T, bool) {
 	}
 	result := v.Current.data
 	v.last = v.Current
-	v.Current = v.Current.next
+	if v.descending {
+		v.Current = v.Current.prev
+	} else {
+		v.Current = v.Current.next
+		v.index++
+	}
 	return result, true
 }
 
+func (v *LinkedListIterator[T]) HasNext() bool {
+	v.checkMod()
+	return v.Current != nil
+}
+
+func (v *LinkedListIterator[T]) HasPrevious() bool {
+	v.checkMod()
+	return v.index > 0
+}
+
+func (v *LinkedListIterator[T]) Previous() (T, bool) {
+	v.checkMod()
+	if v.index <= 0 {
+		var zv T
+		return zv, false
+	}
+	if v.Current == nil {
+		v.Current = v.Src.tail
+	} else {
+		v.Current = v.Current.prev
+	}
+	v.last = v.Current
+	v.index--
+	return v.Current.data, true
+}
+
+func (v *LinkedListIterator[T]) NextIndex() int {
+	return v.index
+}
+
+func (v *LinkedListIterator[T]) PreviousIndex() int {
+	return v.index - 1
+}
+
 func (v *LinkedListIterator[T]) Remove() {
 	v.checkMod()
 	defer v.applyMod()
 	if v.last != nil {
+		if v.last == v.Current {
+			// Returned by Previous(), the cursor stays at the same index
+			v.Current = v.last.next
+		} else if !v.descending {
+			v.index--
+		}
 		v.Src.removeNode(v.last)
 		v.last = nil
 	} else {
@@ -322,6 +455,20 @@ func (v *LinkedListIterator[T]) Remove() {
 	}
 }
 
+// Inserts data before the cursor
+func (v *LinkedListIterator[T]) Add(data T) {
+	v.checkMod()
+	if v.Current == nil {
+		v.Src.AddTail(data)
+		v.generation = v.Src.generation
+	} else {
+		defer v.applyMod()
+		v.Src.addBefore(v.Current, data)
+	}
+	v.last = nil
+	v.index++
+}
+
 func (v *LinkedListIterator[T]) applyMod() {
 	v.generation++
 	v.Src.generation = v.generation
@@ -329,6 +476,9 @@ func (v *LinkedListIterator[T]) applyMod() {
 
 func (v *LinkedListIterator[T]) Set(data T) T {
 	v.checkMod()
+	if v.last == nil {
+		panic("Don't call Set when you have not read, or you have removed")
+	}
 	defer v.applyMod()
 	result := v.last.data
 	v.last.data = data
@@ -423,6 +573,61 @@ func (v *LinkedList[T]) LastIndexOfFunc(what T, equals coll.Equalizer[T]) int {
 	return -1
 }
 
+// Queue operations. Offer adds to the tail, Poll and Peek read from the head
+func (v *LinkedList[T]) Offer(data T) bool {
+	return v.AddTail(data)
+}
+
+func (v *LinkedList[T]) Poll() (T, bool) {
+	return v.PollFirst()
+}
+
+func (v *LinkedList[T]) Peek() (T, bool) {
+	return v.PeekFirst()
+}
+
+// Deque operations
+func (v *LinkedList[T]) OfferFirst(data T) bool {
+	return v.AddHead(data)
+}
+
+func (v *LinkedList[T]) OfferLast(data T) bool {
+	return v.AddTail(data)
+}
+
+func (v *LinkedList[T]) PollFirst() (T, bool) {
+	ok, data := v.RemoveHead()
+	return data, ok
+}
+
+func (v *LinkedList[T]) PollLast() (T, bool) {
+	ok, data := v.RemoveTail()
+	return data, ok
+}
+
+func (v *LinkedList[T]) PeekFirst() (result T, ok bool) {
+	if v.head == nil {
+		return
+	}
+	return v.head.data, true
+}
+
+func (v *LinkedList[T]) PeekLast() (result T, ok bool) {
+	if v.tail == nil {
+		return
+	}
+	return v.tail.data, true
+}
+
+// Stack operations on the head of the list
+func (v *LinkedList[T]) Push(data T) {
+	v.AddHead(data)
+}
+
+func (v *LinkedList[T]) Pop() (T, bool) {
+	return v.PollFirst()
+}
+
 func NewLinkedList[T any]() *LinkedList[T] {
 	return &LinkedList[T]{}
 }
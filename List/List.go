@@ -47,11 +47,49 @@ type List[T any] interface {
 	// Make a copy of list as sublist, from fromIndexIncluded, to endIndexExcluded
 	CopySubList(fromIndexIncluded int, endIndexExcluded int) (newList List[T])
 
+	// Returns a live view of the list from fromIndexIncluded, to endIndexExcluded.
+	// list.SubList(2, 5).Clear() removes 3 elements from list. See SubListView
+	SubList(fromIndexIncluded int, endIndexExcluded int) (view List[T])
+
 	// Make a copy of the list
 	Copy() (newList List[T])
 
 	// Reverse a list and return as new list
 	Reverse() (newList List[T])
+
+	// Sort the list in place with a stable sort
+	Sort(comparator coll.Comparator[T])
+
+	// Returns a ListIterator positioned at the head
+	ListIterator() ListIterator[T]
+
+	// Returns a ListIterator positioned before index. The first Next() returns the element at index.
+	// index can be Size(), which positions the iterator after the last element
+	ListIteratorAt(index int) ListIterator[T]
+}
+
+// Iterator that can move in both directions.
+// Remove() and Set() apply to the element returned by the last Next() or Previous()
+type ListIterator[T any] interface {
+	coll.Iterator[T]
+
+	// Whether Next() would return an element
+	HasNext() bool
+
+	// Whether Previous() would return an element
+	HasPrevious() bool
+
+	// Move the cursor backwards and return the element it passed
+	Previous() (T, bool)
+
+	// Index of the element Next() would return, Size() if at the end
+	NextIndex() int
+
+	// Index of the element Previous() would return, -1 if at the beginning
+	PreviousIndex() int
+
+	// Insert element before the cursor. Next() is not affected, Previous() would return the new element
+	Add(element T)
 }
 
 func LinkedListOf[T any](arg ...T) *LinkedList[T] {
@@ -153,7 +191,13 @@ func RetainAllFunc[T any](src coll.Collection[T], what coll.Collection[T], equal
 }
 
 func RemoveFirstFunc[T any](iter coll.Iterator[T], data T, equals coll.Equalizer[T]) bool {
-	return true
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if equals(next, data) {
+			iter.Remove()
+			return true
+		}
+	}
+	return false
 }
 
 func RemoveAt[T any](iter coll.Iterator[T], index int) T {
@@ -0,0 +1,88 @@
+package List
+
+import (
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+func testListIterator(t *testing.T, list List[int]) {
+	for i := 0; i < 5; i++ {
+		list.Add(i)
+	}
+	iter := list.ListIteratorAt(list.Size())
+	common.AssertFalse(t, iter.HasNext())
+	reversed := []int{}
+	for iter.HasPrevious() {
+		common.AssertEq(t, iter.PreviousIndex(), 4-len(reversed))
+		previous, _ := iter.Previous()
+		reversed = append(reversed, previous)
+	}
+	common.AssertArrEq(t, reversed, []int{4, 3, 2, 1, 0})
+	_, ok := iter.Previous()
+	common.AssertFalse(t, ok)
+	common.AssertEq(t, iter.NextIndex(), 0)
+
+	// [0,1,2,3,4] => [0,10,1,2,30,4]
+	iter.Next()
+	iter.Add(10)
+	common.AssertEq(t, iter.NextIndex(), 2)
+	next, _ := iter.Next()
+	common.AssertEq(t, next, 1)
+	previous, _ := iter.Previous()
+	common.AssertEq(t, previous, 1)
+	previous, _ = iter.Previous()
+	common.AssertEq(t, previous, 10)
+	next, _ = iter.Next()
+	common.AssertEq(t, next, 10)
+	iter.Next()
+	iter.Next()
+	iter.Next()
+	common.AssertEq(t, iter.Set(30), 3)
+	common.AssertArrEq(t, list.ToArray(), []int{0, 10, 1, 2, 30, 4})
+
+	// Remove after Previous keeps the cursor index
+	iter = list.ListIteratorAt(3)
+	previous, _ = iter.Previous()
+	common.AssertEq(t, previous, 1)
+	iter.Remove()
+	common.AssertEq(t, iter.NextIndex(), 2)
+	next, _ = iter.Next()
+	common.AssertEq(t, next, 2)
+	// Remove after Next moves the cursor back
+	iter.Remove()
+	common.AssertEq(t, iter.NextIndex(), 2)
+	common.AssertArrEq(t, list.ToArray(), []int{0, 10, 30, 4})
+
+	iter = list.ListIteratorAt(list.Size())
+	iter.Add(5)
+	common.AssertFalse(t, iter.HasNext())
+	common.AssertArrEq(t, list.ToArray(), []int{0, 10, 30, 4, 5})
+
+	list.Add(6)
+	func() {
+		defer func() {
+			common.AssertTrue(t, recover() != nil)
+		}()
+		iter.Previous()
+		t.Fatal("Expect concurrent modification panic")
+	}()
+}
+
+func TestListIterator(t *testing.T) {
+	testListIterator(t, NewArrayList[int]())
+	testListIterator(t, NewLinkedList[int]())
+
+	empty := NewLinkedList[int]()
+	iter := empty.ListIterator()
+	iter.Add(1)
+	iter.Add(2)
+	common.AssertArrEq(t, empty.ToArray(), []int{1, 2})
+	last, _ := empty.PeekLast()
+	common.AssertEq(t, last, 2)
+
+	snapshot := CopyOnWriteArrayListOf(1, 2, 3).ListIteratorAt(3)
+	previous, _ := snapshot.Previous()
+	common.AssertEq(t, previous, 3)
+	common.AssertEq(t, snapshot.NextIndex(), 2)
+}
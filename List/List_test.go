@@ -255,3 +255,11 @@ func T1estList1(t *testing.T) {
 	lists = LinkedListOf("1", "2", "3")
 	common.AssertTrue(t, ListEquals[string](lists.Reverse(), LinkedListOf("3", "2", "1"), coll.DefaultEqualizer[string]()))
 }
+
+func TestRemoveFirst(t *testing.T) {
+	for _, list := range []List[int]{ArrayListOf(1, 2, 1), LinkedListOf(1, 2, 1)} {
+		common.AssertTrue(t, list.RemoveFirst(1))
+		common.AssertArrEq(t, list.ToArray(), []int{2, 1})
+		common.AssertFalse(t, list.RemoveFirst(3))
+	}
+}
@@ -0,0 +1,353 @@
+package List
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/stream"
+)
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// A node of the vector trie. Leaves hold values, the others hold children.
+// Nodes are never modified once they are reachable from a vector
+type vectorNode[T any] struct {
+	children []*vectorNode[T]
+	values   []T
+}
+
+// An immutable list stored in a 32-way trie. Append(), With() and Pop() return a new vector
+// and leave this one untouched, the two share all nodes except the path to the changed element,
+// so each update costs O(log32 n) instead of a full copy.
+// It implements List, methods of List that modify it panic. It is safe to share across goroutines
+type PersistentVector[T any] struct {
+	immutableListMutators[T]
+	size  int
+	shift int
+	root  *vectorNode[T]
+	// The last, partially filled leaf is kept outside of the trie, so most appends are cheap
+	tail []T
+}
+
+// Return an empty PersistentVector
+func NewPersistentVector[T any]() *PersistentVector[T] {
+	return &PersistentVector[T]{shift: vectorBits, root: &vectorNode[T]{}}
+}
+
+// Return a PersistentVector with the given elements
+func PersistentVectorOf[T any](args ...T) *PersistentVector[T] {
+	return NewPersistentVector[T]().Append(args...)
+}
+
+// Return a PersistentVector with the elements of the collection, in iteration order
+func PersistentVectorFrom[T any](elements coll.Collection[T]) *PersistentVector[T] {
+	return PersistentVectorOf(elements.ToArray()...)
+}
+
+// Index of the first element in the tail
+func (v *PersistentVector[T]) tailOffset() int {
+	if v.size < vectorWidth {
+		return 0
+	}
+	return ((v.size - 1) >> vectorBits) << vectorBits
+}
+
+// The leaf holding the element at index
+func (v *PersistentVector[T]) arrayFor(index int) []T {
+	if index >= v.tailOffset() {
+		return v.tail
+	}
+	node := v.root
+	for level := v.shift; level > 0; level -= vectorBits {
+		node = node.children[(index>>level)&vectorMask]
+	}
+	return node.values
+}
+
+// Returns a new vector with values added at the end
+func (v *PersistentVector[T]) Append(values ...T) *PersistentVector[T] {
+	result := v
+	for _, next := range values {
+		result = result.appendOne(next)
+	}
+	return result
+}
+
+func (v *PersistentVector[T]) appendOne(value T) *PersistentVector[T] {
+	if v.size-v.tailOffset() < vectorWidth {
+		tail := make([]T, len(v.tail)+1)
+		copy(tail, v.tail)
+		tail[len(v.tail)] = value
+		return &PersistentVector[T]{size: v.size + 1, shift: v.shift, root: v.root, tail: tail}
+	}
+	// The tail is full, push it into the trie
+	tailNode := &vectorNode[T]{values: v.tail}
+	shift := v.shift
+	var root *vectorNode[T]
+	if (v.size >> vectorBits) > (1 << v.shift) {
+		// No room left under the root, grow the trie by one level
+		root = &vectorNode[T]{children: []*vectorNode[T]{v.root, newPath(v.shift, tailNode)}}
+		shift += vectorBits
+	} else {
+		root = v.pushTail(v.shift, v.root, tailNode)
+	}
+	return &PersistentVector[T]{size: v.size + 1, shift: shift, root: root, tail: []T{value}}
+}
+
+func (v *PersistentVector[T]) pushTail(level int, parent *vectorNode[T], tailNode *vectorNode[T]) *vectorNode[T] {
+	subIndex := ((v.size - 1) >> level) & vectorMask
+	var toInsert *vectorNode[T]
+	if level == vectorBits {
+		toInsert = tailNode
+	} else if subIndex < len(parent.children) {
+		toInsert = v.pushTail(level-vectorBits, parent.children[subIndex], tailNode)
+	} else {
+		toInsert = newPath(level-vectorBits, tailNode)
+	}
+	children := make([]*vectorNode[T], len(parent.children), len(parent.children)+1)
+	copy(children, parent.children)
+	if subIndex < len(children) {
+		children[subIndex] = toInsert
+	} else {
+		children = append(children, toInsert)
+	}
+	return &vectorNode[T]{children: children}
+}
+
+// A chain of single child nodes from level down to node
+func newPath[T any](level int, node *vectorNode[T]) *vectorNode[T] {
+	if level == 0 {
+		return node
+	}
+	return &vectorNode[T]{children: []*vectorNode[T]{newPath(level-vectorBits, node)}}
+}
+
+// Returns a new vector with the element at index replaced by value. index can be Size(), which appends value
+func (v *PersistentVector[T]) With(index int, value T) *PersistentVector[T] {
+	if index == v.size {
+		return v.appendOne(value)
+	}
+	indexCheck(index, v.size)
+	if index >= v.tailOffset() {
+		tail := copyArray(v.tail)
+		tail[index&vectorMask] = value
+		return &PersistentVector[T]{size: v.size, shift: v.shift, root: v.root, tail: tail}
+	}
+	return &PersistentVector[T]{size: v.size, shift: v.shift, root: doAssoc(v.shift, v.root, index, value), tail: v.tail}
+}
+
+func doAssoc[T any](level int, node *vectorNode[T], index int, value T) *vectorNode[T] {
+	if level == 0 {
+		values := copyArray(node.values)
+		values[index&vectorMask] = value
+		return &vectorNode[T]{values: values}
+	}
+	children := make([]*vectorNode[T], len(node.children))
+	copy(children, node.children)
+	subIndex := (index >> level) & vectorMask
+	children[subIndex] = doAssoc(level-vectorBits, children[subIndex], index, value)
+	return &vectorNode[T]{children: children}
+}
+
+// Returns a new vector without the last element. Panics if the vector is empty
+func (v *PersistentVector[T]) Pop() *PersistentVector[T] {
+	if v.size == 0 {
+		panic("Vector is empty")
+	}
+	if v.size == 1 {
+		return NewPersistentVector[T]()
+	}
+	if v.size-v.tailOffset() > 1 {
+		// Leaves are never written to, so the shorter tail can share the array
+		return &PersistentVector[T]{size: v.size - 1, shift: v.shift, root: v.root, tail: v.tail[:len(v.tail)-1]}
+	}
+	// The tail becomes empty, the last leaf of the trie becomes the new tail
+	tail := v.arrayFor(v.size - 2)
+	root := v.popTail(v.shift, v.root)
+	shift := v.shift
+	if root == nil {
+		root = &vectorNode[T]{}
+	}
+	if shift > vectorBits && len(root.children) == 1 {
+		root = root.children[0]
+		shift -= vectorBits
+	}
+	return &PersistentVector[T]{size: v.size - 1, shift: shift, root: root, tail: tail}
+}
+
+// Remove the last leaf under node, returns nil if node becomes empty
+func (v *PersistentVector[T]) popTail(level int, node *vectorNode[T]) *vectorNode[T] {
+	subIndex := ((v.size - 2) >> level) & vectorMask
+	if level > vectorBits {
+		child := v.popTail(level-vectorBits, node.children[subIndex])
+		if child == nil && subIndex == 0 {
+			return nil
+		}
+		if child == nil {
+			return &vectorNode[T]{children: copyArray(node.children[:subIndex])}
+		}
+		children := copyArray(node.children[:subIndex+1])
+		children[subIndex] = child
+		return &vectorNode[T]{children: children}
+	}
+	if subIndex == 0 {
+		return nil
+	}
+	return &vectorNode[T]{children: copyArray(node.children[:subIndex])}
+}
+
+func (v *PersistentVector[T]) Size() int {
+	return v.size
+}
+
+func (v *PersistentVector[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+func (v *PersistentVector[T]) Get(index int) T {
+	indexCheck(index, v.size)
+	return v.arrayFor(index)[index&vectorMask]
+}
+
+func (v *PersistentVector[T]) Contains(data T) bool {
+	return v.ContainsFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *PersistentVector[T]) ContainsFunc(data T, equals coll.Equalizer[T]) bool {
+	return v.IndexOfFunc(data, equals) != -1
+}
+
+func (v *PersistentVector[T]) IndexOf(data T) int {
+	return v.IndexOfFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *PersistentVector[T]) IndexOfFunc(data T, equals coll.Equalizer[T]) int {
+	return FindItem(v.Iterator(), data, equals)
+}
+
+func (v *PersistentVector[T]) LastIndexOf(data T) int {
+	return v.LastIndexOfFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *PersistentVector[T]) LastIndexOfFunc(data T, equals coll.Equalizer[T]) int {
+	iter := v.ListIteratorAt(v.size)
+	for next, ok := iter.Previous(); ok; next, ok = iter.Previous() {
+		if equals(next, data) {
+			return iter.NextIndex()
+		}
+	}
+	return -1
+}
+
+func (v *PersistentVector[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *PersistentVector[T]) ToArray() []T {
+	result := make([]T, 0, v.size)
+	for index := 0; index < v.size; index += vectorWidth {
+		result = append(result, v.arrayFor(index)...)
+	}
+	return result
+}
+
+func (v *PersistentVector[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *PersistentVector[T]) Copy() List[T] {
+	return ArrayListOf(v.ToArray()...)
+}
+
+func (v *PersistentVector[T]) CopySubList(fromIndexIncluded int, endIndexExcluded int) List[T] {
+	subListRangeCheck(fromIndexIncluded, endIndexExcluded, v.size)
+	return ArrayListOf(v.ToArray()[fromIndexIncluded:endIndexExcluded]...)
+}
+
+func (v *PersistentVector[T]) Reverse() List[T] {
+	data := reverseCopyArray(v.ToArray())
+	return &ArrayList[T]{buffer: data, length: len(data)}
+}
+
+// Returns an ImmutableList with the elements in range. The vector never changes, so it is as good as a view
+func (v *PersistentVector[T]) SubList(fromIndexIncluded int, endIndexExcluded int) List[T] {
+	subListRangeCheck(fromIndexIncluded, endIndexExcluded, v.size)
+	return &ImmutableList[T]{data: v.ToArray()[fromIndexIncluded:endIndexExcluded]}
+}
+
+func (v *PersistentVector[T]) Iterator() coll.Iterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+func (v *PersistentVector[T]) ListIterator() ListIterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+func (v *PersistentVector[T]) ListIteratorAt(index int) ListIterator[T] {
+	indexCheck(index, v.size+1)
+	return &PersistentVectorIterator[T]{src: v, currentIndex: index, leafStart: -1}
+}
+
+// Iterates a PersistentVector, looking up each leaf only once
+type PersistentVectorIterator[T any] struct {
+	src          *PersistentVector[T]
+	currentIndex int
+	leaf         []T
+	leafStart    int
+}
+
+func (v *PersistentVectorIterator[T]) get(index int) T {
+	if start := index &^ vectorMask; start != v.leafStart {
+		v.leaf = v.src.arrayFor(index)
+		v.leafStart = start
+	}
+	return v.leaf[index&vectorMask]
+}
+
+func (v *PersistentVectorIterator[T]) HasNext() bool {
+	return v.currentIndex < v.src.size
+}
+
+func (v *PersistentVectorIterator[T]) HasPrevious() bool {
+	return v.currentIndex > 0
+}
+
+func (v *PersistentVectorIterator[T]) Next() (result T, ok bool) {
+	if v.currentIndex >= v.src.size {
+		return result, false
+	}
+	result = v.get(v.currentIndex)
+	v.currentIndex++
+	return result, true
+}
+
+func (v *PersistentVectorIterator[T]) Previous() (result T, ok bool) {
+	if v.currentIndex <= 0 {
+		return result, false
+	}
+	v.currentIndex--
+	return v.get(v.currentIndex), true
+}
+
+func (v *PersistentVectorIterator[T]) NextIndex() int {
+	return v.currentIndex
+}
+
+func (v *PersistentVectorIterator[T]) PreviousIndex() int {
+	return v.currentIndex - 1
+}
+
+func (v *PersistentVectorIterator[T]) Remove() {
+	immutable()
+}
+
+func (v *PersistentVectorIterator[T]) Set(data T) T {
+	immutable()
+	return data
+}
+
+func (v *PersistentVectorIterator[T]) Add(data T) {
+	immutable()
+}
@@ -0,0 +1,76 @@
+package List
+
+import (
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+func TestPersistentVector(t *testing.T) {
+	n := 5000
+	versions := []*PersistentVector[int]{NewPersistentVector[int]()}
+	for i := 0; i < n; i++ {
+		versions = append(versions, versions[i].Append(i))
+	}
+	// Every version still sees exactly what it had
+	for size, version := range versions {
+		common.AssertEq(t, version.Size(), size)
+		if size > 0 {
+			common.AssertEq(t, version.Get(size-1), size-1)
+			common.AssertEq(t, version.Get(size/2), size/2)
+		}
+	}
+	full := versions[n]
+	expected := make([]int, n)
+	for i := range expected {
+		expected[i] = i
+	}
+	common.AssertArrEq(t, full.ToArray(), expected)
+
+	updated := full
+	for i := 0; i < n; i += 7 {
+		updated = updated.With(i, -i)
+	}
+	for i := 0; i < n; i++ {
+		common.AssertEq(t, full.Get(i), i)
+		if i%7 == 0 {
+			common.AssertEq(t, updated.Get(i), -i)
+		} else {
+			common.AssertEq(t, updated.Get(i), i)
+		}
+	}
+	common.AssertEq(t, full.With(n, n).Get(n), n)
+	assertPanics(t, func() { full.With(n+1, 0) })
+
+	popped := full
+	for size := n; size > 0; size-- {
+		popped = popped.Pop()
+		common.AssertEq(t, popped.Size(), size-1)
+		common.AssertArrEq(t, popped.ToArray(), expected[:size-1])
+	}
+	assertPanics(t, func() { popped.Pop() })
+	common.AssertEq(t, full.Size(), n)
+	common.AssertEq(t, full.Pop().Append(1).Get(n-1), 1)
+}
+
+func TestPersistentVectorAsList(t *testing.T) {
+	var l List[int] = PersistentVectorOf(1, 2, 3, 2)
+	common.AssertEq(t, l.IndexOf(2), 1)
+	common.AssertEq(t, l.LastIndexOf(2), 3)
+	common.AssertTrue(t, l.Contains(3))
+	common.AssertArrEq(t, l.SubList(1, 3).ToArray(), []int{2, 3})
+	common.AssertArrEq(t, l.Reverse().ToArray(), []int{2, 3, 2, 1})
+	assertPanics(t, func() { l.Add(4) })
+	assertPanics(t, func() { l.Set(0, 4) })
+
+	iter := l.ListIteratorAt(4)
+	previous, _ := iter.Previous()
+	common.AssertEq(t, previous, 2)
+	common.AssertEq(t, iter.NextIndex(), 3)
+	assertPanics(t, func() { iter.Remove() })
+
+	copied := l.Copy()
+	copied.Add(5)
+	common.AssertEq(t, l.Size(), 4)
+	common.AssertEq(t, PersistentVectorFrom[int](copied).Size(), 5)
+}
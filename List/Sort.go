@@ -0,0 +1,52 @@
+package List
+
+import coll "github.com/wushilin/gojava/Collection"
+
+// Sort list in natural order
+func Sort[T coll.Ordered](list List[T]) {
+	list.Sort(coll.NaturalOrder[T]())
+}
+
+// Stable merge sort of data, in place
+func mergeSort[T any](data []T, comparator coll.Comparator[T]) {
+	if len(data) < 2 {
+		return
+	}
+	mergeSortWith(data, make([]T, len(data)), comparator)
+}
+
+// Sort data using buffer (of the same length) as temporary storage
+func mergeSortWith[T any](data []T, buffer []T, comparator coll.Comparator[T]) {
+	if len(data) <= 8 {
+		insertionSort(data, comparator)
+		return
+	}
+	middle := len(data) / 2
+	mergeSortWith(data[:middle], buffer[:middle], comparator)
+	mergeSortWith(data[middle:], buffer[middle:], comparator)
+	if comparator(data[middle-1], data[middle]) <= 0 {
+		// Already in order
+		return
+	}
+	copy(buffer, data)
+	left, right := buffer[:middle], buffer[middle:]
+	i, j := 0, 0
+	for k := range data {
+		// Take from the left on ties to keep the sort stable
+		if j >= len(right) || (i < len(left) && comparator(left[i], right[j]) <= 0) {
+			data[k] = left[i]
+			i++
+		} else {
+			data[k] = right[j]
+			j++
+		}
+	}
+}
+
+func insertionSort[T any](data []T, comparator coll.Comparator[T]) {
+	for i := 1; i < len(data); i++ {
+		for j := i; j > 0 && comparator(data[j-1], data[j]) > 0; j-- {
+			data[j-1], data[j] = data[j], data[j-1]
+		}
+	}
+}
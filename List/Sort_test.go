@@ -0,0 +1,76 @@
+package List
+
+import (
+	"math/rand"
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/common"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func testSort(t *testing.T, list List[person]) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		list.Add(person{name: string(rune('a' + rnd.Intn(26))), age: i})
+	}
+	byName := coll.Comparing(func(p person) string {
+		return p.name
+	})
+	list.Sort(byName)
+	common.AssertEq(t, list.Size(), 1000)
+	previous := list.Get(0)
+	for i := 1; i < list.Size(); i++ {
+		next := list.Get(i)
+		common.AssertTrue(t, previous.name <= next.name)
+		if previous.name == next.name {
+			// Stable: equal names keep the insertion order
+			common.AssertTrue(t, previous.age < next.age)
+		}
+		previous = next
+	}
+
+	byAgeDescending := coll.Comparing(func(p person) int {
+		return p.age
+	}).Reversed()
+	list.Sort(byName.Reversed().ThenComparing(byAgeDescending))
+	first := list.Get(0)
+	common.AssertEq(t, first.name, "z")
+	common.AssertTrue(t, first.age > list.Get(1).age || list.Get(1).name != "z")
+	last := list.Get(list.Size() - 1)
+	common.AssertEq(t, last.name, "a")
+}
+
+func TestSort(t *testing.T) {
+	testSort(t, NewArrayList[person]())
+	testSort(t, NewLinkedList[person]())
+	testSort(t, NewCopyOnWriteArrayList[person]())
+
+	list := LinkedListOf(5, 3, 1, 4, 2)
+	Sort[int](list)
+	common.AssertArrEq(t, list.ToArray(), []int{1, 2, 3, 4, 5})
+	last, _ := list.PeekLast()
+	common.AssertEq(t, last, 5)
+	reversed := []int{}
+	iter := list.DescendingIterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		reversed = append(reversed, next)
+	}
+	common.AssertArrEq(t, reversed, []int{5, 4, 3, 2, 1})
+
+	one, two := 1, 2
+	pointers := ArrayListOf(&two, nil, &one)
+	byValue := func(a, b *int) int {
+		return *a - *b
+	}
+	pointers.Sort(coll.NullsFirst[*int](byValue))
+	common.AssertTrue(t, pointers.Get(0) == nil)
+	common.AssertEq(t, *pointers.Get(1), 1)
+	pointers.Sort(coll.NullsLast[*int](byValue))
+	common.AssertTrue(t, pointers.Get(2) == nil)
+	common.AssertEq(t, *pointers.Get(0), 1)
+}
@@ -0,0 +1,308 @@
+package List
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/stream"
+)
+
+// Lists that can back a SubListView
+type subListRoot[T any] interface {
+	List[T]
+	// Changes whenever the list is modified
+	modCount() int
+	// Remove elements from fromIndexIncluded to endIndexExcluded
+	removeRange(fromIndexIncluded int, endIndexExcluded int)
+}
+
+// A live view of a range of a list, returned by List.SubList().
+// Changes through the view show up in the parent list.
+// Once the parent is modified other than through the view (Set() included), any use of the view panics
+type SubListView[T any] struct {
+	root       subListRoot[T]
+	parent     *SubListView[T]
+	offset     int
+	size       int
+	generation int
+}
+
+func subListRangeCheck(fromIndexIncluded int, endIndexExcluded int, size int) {
+	if fromIndexIncluded < 0 || endIndexExcluded > size {
+		panic("Index Out of Bound")
+	}
+	if fromIndexIncluded > endIndexExcluded {
+		panic("Invalid start & end combination")
+	}
+}
+
+func newSubListView[T any](root subListRoot[T], parent *SubListView[T], offset int, fromIndexIncluded int, endIndexExcluded int) *SubListView[T] {
+	return &SubListView[T]{
+		root:       root,
+		parent:     parent,
+		offset:     offset + fromIndexIncluded,
+		size:       endIndexExcluded - fromIndexIncluded,
+		generation: root.modCount(),
+	}
+}
+
+func (v *SubListView[T]) checkMod() {
+	if v.generation != v.root.modCount() {
+		panic("Concurrent modification")
+	}
+}
+
+// Record a change made through this view, for this view and the views it was created from
+func (v *SubListView[T]) applyMod(sizeChange int) {
+	for view := v; view != nil; view = view.parent {
+		view.size += sizeChange
+		view.generation = view.root.modCount()
+	}
+}
+
+func (v *SubListView[T]) Size() int {
+	v.checkMod()
+	return v.size
+}
+
+func (v *SubListView[T]) IsEmpty() bool {
+	return v.Size() == 0
+}
+
+func (v *SubListView[T]) Get(index int) T {
+	v.checkMod()
+	indexCheck(index, v.size)
+	return v.root.Get(v.offset + index)
+}
+
+func (v *SubListView[T]) Set(index int, newValue T) T {
+	v.checkMod()
+	indexCheck(index, v.size)
+	defer v.applyMod(0)
+	return v.root.Set(v.offset+index, newValue)
+}
+
+func (v *SubListView[T]) Add(element T) bool {
+	return v.AddAt(v.Size(), element)
+}
+
+func (v *SubListView[T]) AddAt(index int, element T) bool {
+	v.checkMod()
+	indexCheck(index, v.size+1)
+	defer v.applyMod(1)
+	return v.root.AddAt(v.offset+index, element)
+}
+
+func (v *SubListView[T]) AddAll(elements coll.Collection[T]) int {
+	return v.AddAllAt(v.Size(), elements)
+}
+
+func (v *SubListView[T]) AddAllAt(index int, elements coll.Collection[T]) int {
+	v.checkMod()
+	indexCheck(index, v.size+1)
+	// Copy first, elements might be this view or its parent
+	count := v.root.AddAllAt(v.offset+index, ArrayListOf(elements.ToArray()...))
+	v.applyMod(count)
+	return count
+}
+
+func (v *SubListView[T]) RemoveAt(index int) T {
+	v.checkMod()
+	indexCheck(index, v.size)
+	defer v.applyMod(-1)
+	return v.root.RemoveAt(v.offset + index)
+}
+
+func (v *SubListView[T]) RemoveFirst(data T) bool {
+	return v.RemoveFirstFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *SubListView[T]) RemoveFirstFunc(data T, equals coll.Equalizer[T]) bool {
+	index := v.IndexOfFunc(data, equals)
+	if index == -1 {
+		return false
+	}
+	v.RemoveAt(index)
+	return true
+}
+
+func (v *SubListView[T]) Clear() int {
+	v.checkMod()
+	count := v.size
+	v.root.removeRange(v.offset, v.offset+v.size)
+	v.applyMod(-count)
+	return count
+}
+
+// Replace the content of the view with elements
+func (v *SubListView[T]) replaceWith(elements []T) {
+	count := v.size
+	v.root.removeRange(v.offset, v.offset+v.size)
+	v.root.AddAllAt(v.offset, ArrayListOf(elements...))
+	v.applyMod(len(elements) - count)
+}
+
+// Keep only the elements for which keep returns true. Returns the number of elements removed
+func (v *SubListView[T]) filter(keep func(T) bool) int {
+	kept := []T{}
+	v.ForEach(func(i T) bool {
+		if keep(i) {
+			kept = append(kept, i)
+		}
+		return true
+	})
+	removed := v.size - len(kept)
+	if removed > 0 {
+		v.replaceWith(kept)
+	}
+	return removed
+}
+
+func (v *SubListView[T]) RemoveAll(collection coll.Collection[T]) int {
+	return v.RemoveAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *SubListView[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.filter(func(i T) bool {
+		return !collection.ContainsFunc(i, equals)
+	})
+}
+
+func (v *SubListView[T]) RetainAll(collection coll.Collection[T]) int {
+	return v.RetainAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *SubListView[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.filter(func(i T) bool {
+		return collection.ContainsFunc(i, equals)
+	})
+}
+
+func (v *SubListView[T]) Sort(comparator coll.Comparator[T]) {
+	sorted := v.ToArray()
+	mergeSort(sorted, comparator)
+	v.replaceWith(sorted)
+}
+
+func (v *SubListView[T]) Contains(data T) bool {
+	return v.ContainsFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *SubListView[T]) ContainsFunc(data T, equals coll.Equalizer[T]) bool {
+	return v.IndexOfFunc(data, equals) != -1
+}
+
+func (v *SubListView[T]) IndexOf(data T) int {
+	return v.IndexOfFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *SubListView[T]) IndexOfFunc(data T, equals coll.Equalizer[T]) int {
+	return FindItem(v.Iterator(), data, equals)
+}
+
+func (v *SubListView[T]) LastIndexOf(data T) int {
+	return v.LastIndexOfFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *SubListView[T]) LastIndexOfFunc(data T, equals coll.Equalizer[T]) int {
+	return FindLastItem(v.Iterator(), data, equals)
+}
+
+func (v *SubListView[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *SubListView[T]) ToArray() []T {
+	return coll.ToArray(v.Size(), v.Iterator())
+}
+
+func (v *SubListView[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *SubListView[T]) Copy() List[T] {
+	return ArrayListOf(v.ToArray()...)
+}
+
+func (v *SubListView[T]) CopySubList(fromIndexIncluded int, endIndexExcluded int) List[T] {
+	return v.SubList(fromIndexIncluded, endIndexExcluded).Copy()
+}
+
+func (v *SubListView[T]) Reverse() List[T] {
+	data := reverseCopyArray(v.ToArray())
+	return &ArrayList[T]{buffer: data, length: len(data)}
+}
+
+func (v *SubListView[T]) SubList(fromIndexIncluded int, endIndexExcluded int) List[T] {
+	v.checkMod()
+	subListRangeCheck(fromIndexIncluded, endIndexExcluded, v.size)
+	return newSubListView(v.root, v, v.offset, fromIndexIncluded, endIndexExcluded)
+}
+
+func (v *SubListView[T]) Iterator() coll.Iterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+func (v *SubListView[T]) ListIterator() ListIterator[T] {
+	return v.ListIteratorAt(0)
+}
+
+// The iterator is backed by an iterator of the parent list, so it supports the same operations
+func (v *SubListView[T]) ListIteratorAt(index int) ListIterator[T] {
+	v.checkMod()
+	indexCheck(index, v.size+1)
+	return &SubListIterator[T]{src: v, iter: v.root.ListIteratorAt(v.offset + index)}
+}
+
+type SubListIterator[T any] struct {
+	src  *SubListView[T]
+	iter ListIterator[T]
+}
+
+func (v *SubListIterator[T]) HasNext() bool {
+	v.src.checkMod()
+	return v.NextIndex() < v.src.size
+}
+
+func (v *SubListIterator[T]) HasPrevious() bool {
+	v.src.checkMod()
+	return v.NextIndex() > 0
+}
+
+func (v *SubListIterator[T]) Next() (result T, ok bool) {
+	if !v.HasNext() {
+		return result, false
+	}
+	return v.iter.Next()
+}
+
+func (v *SubListIterator[T]) Previous() (result T, ok bool) {
+	if !v.HasPrevious() {
+		return result, false
+	}
+	return v.iter.Previous()
+}
+
+func (v *SubListIterator[T]) NextIndex() int {
+	return v.iter.NextIndex() - v.src.offset
+}
+
+func (v *SubListIterator[T]) PreviousIndex() int {
+	return v.NextIndex() - 1
+}
+
+func (v *SubListIterator[T]) Remove() {
+	v.src.checkMod()
+	v.iter.Remove()
+	v.src.applyMod(-1)
+}
+
+func (v *SubListIterator[T]) Set(data T) T {
+	v.src.checkMod()
+	defer v.src.applyMod(0)
+	return v.iter.Set(data)
+}
+
+func (v *SubListIterator[T]) Add(data T) {
+	v.src.checkMod()
+	v.iter.Add(data)
+	v.src.applyMod(1)
+}
@@ -0,0 +1,70 @@
+package List
+
+import (
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+func testSubList(t *testing.T, list List[int]) {
+	for i := 0; i < 10; i++ {
+		list.Add(i)
+	}
+	view := list.SubList(2, 8)
+	common.AssertEq(t, view.Size(), 6)
+	common.AssertEq(t, view.Get(0), 2)
+	common.AssertEq(t, view.IndexOf(7), 5)
+	common.AssertEq(t, view.IndexOf(8), -1)
+
+	view.Set(0, 20)
+	view.AddAt(1, 21)
+	view.Add(22)
+	view.RemoveAt(2)
+	common.AssertArrEq(t, view.ToArray(), []int{20, 21, 4, 5, 6, 7, 22})
+	common.AssertArrEq(t, list.ToArray(), []int{0, 1, 20, 21, 4, 5, 6, 7, 22, 8, 9})
+
+	// Changes through a nested view update the outer view
+	inner := view.SubList(2, 5)
+	common.AssertEq(t, inner.RemoveAll(ArrayListOf(5)), 1)
+	inner.Sort(func(a, b int) int {
+		return b - a
+	})
+	common.AssertArrEq(t, view.ToArray(), []int{20, 21, 6, 4, 7, 22})
+
+	iter := view.ListIteratorAt(view.Size())
+	previous, _ := iter.Previous()
+	common.AssertEq(t, previous, 22)
+	iter.Remove()
+	iter.Add(23)
+	common.AssertEq(t, view.Size(), 6)
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		t.Fatalf("Iterator went past the view: %v", next)
+	}
+
+	common.AssertEq(t, view.Clear(), 6)
+	common.AssertTrue(t, view.IsEmpty())
+	common.AssertArrEq(t, list.ToArray(), []int{0, 1, 8, 9})
+	view.AddAll(ArrayListOf(2, 3))
+	common.AssertArrEq(t, list.ToArray(), []int{0, 1, 2, 3, 8, 9})
+
+	list.Add(10)
+	func() {
+		defer func() {
+			common.AssertTrue(t, recover() != nil)
+		}()
+		view.Size()
+		t.Fatal("Expect concurrent modification panic")
+	}()
+}
+
+func TestSubList(t *testing.T) {
+	testSubList(t, NewArrayList[int]())
+	testSubList(t, NewLinkedList[int]())
+
+	list := CopyOnWriteArrayListOf(1, 2, 3, 4, 5)
+	view := list.SubList(1, 4)
+	common.AssertEq(t, view.RetainAll(ArrayListOf(2, 4)), 1)
+	view.SubList(0, 1).Clear()
+	common.AssertArrEq(t, list.ToArray(), []int{1, 4, 5})
+	common.AssertArrEq(t, view.ToArray(), []int{4})
+}
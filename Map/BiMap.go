@@ -0,0 +1,271 @@
+package Map
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+// A Map whose values are unique as well as its keys, so it can be looked up in both directions.
+// Put panics when the value belongs to another key, ForcePut replaces that entry instead.
+// Inverse() is a BiMap from values to keys over the same storage
+type BiMap[K comparable, V comparable] struct {
+	forward    map[K]V
+	backward   map[V]K
+	generation *int
+	inverse    *BiMap[V, K]
+}
+
+func (v *BiMap[K, V]) applyMod() {
+	*v.generation++
+}
+
+func (v *BiMap[K, V]) modCount() int {
+	return *v.generation
+}
+
+// Returns the inverse view, which maps the values to their keys. Changes to either map show in both
+func (v *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	if v.inverse == nil {
+		v.inverse = &BiMap[V, K]{forward: v.backward, backward: v.forward, generation: v.generation, inverse: v}
+	}
+	return v.inverse
+}
+
+// Returns the key of value, if no result found, ok is set to false
+func (v *BiMap[K, V]) GetKey(value V) (key K, ok bool) {
+	key, ok = v.backward[value]
+	return
+}
+
+func (v *BiMap[K, V]) Size() int {
+	return len(v.forward)
+}
+
+func (v *BiMap[K, V]) Contains(key K) bool {
+	_, ok := v.forward[key]
+	return ok
+}
+
+func (v *BiMap[K, V]) Get(key K) (result V, ok bool) {
+	result, ok = v.forward[key]
+	return
+}
+
+// Put value by key. Panics if value is already mapped to another key
+func (v *BiMap[K, V]) Put(key K, value V) {
+	if existing, ok := v.backward[value]; ok && existing != key {
+		panic("Value already present in BiMap, use ForcePut to replace it")
+	}
+	v.ForcePut(key, value)
+}
+
+// Put value by key, removing the entry that value was mapped to before, if any
+func (v *BiMap[K, V]) ForcePut(key K, value V) {
+	defer v.applyMod()
+	if existing, ok := v.backward[value]; ok {
+		delete(v.forward, existing)
+	}
+	if old, ok := v.forward[key]; ok {
+		delete(v.backward, old)
+	}
+	v.forward[key] = value
+	v.backward[value] = key
+}
+
+func (v *BiMap[K, V]) Clear() {
+	defer v.applyMod()
+	for key := range v.forward {
+		delete(v.forward, key)
+	}
+	for value := range v.backward {
+		delete(v.backward, value)
+	}
+}
+
+// Test if a value is found, by looking it up in the inverse
+func (v *BiMap[K, V]) ContainsValue(what V) bool {
+	_, ok := v.backward[what]
+	return ok
+}
+
+func (v *BiMap[K, V]) ContainsValueFunc(what V, equals coll.Equalizer[V]) bool {
+	return v.Values().ContainsFunc(what, equals)
+}
+
+// Returns a snapshot of the values, as a HashSet
+func (v *BiMap[K, V]) Values() coll.Collection[V] {
+	result := set.NewHashSet[V]()
+	for value := range v.backward {
+		result.Add(value)
+	}
+	return result
+}
+
+func (v *BiMap[K, V]) KeySet() set.Set[K] {
+	return KeySet[K, V](v)
+}
+
+func (v *BiMap[K, V]) ValuesView() coll.Collection[V] {
+	return ValuesView[K, V](v)
+}
+
+func (v *BiMap[K, V]) EntrySet() coll.Collection[Entry[K, V]] {
+	return EntrySet[K, V](v)
+}
+
+func (v *BiMap[K, V]) PutAll(other Map[K, V]) {
+	coll.ForEach(
+		other.Iterator(),
+		func(i KV[K, V]) bool {
+			v.Put(i.Key(), i.Value())
+			return true
+		})
+}
+
+func (v *BiMap[K, V]) Remove(key K) (oldValue V, removed bool) {
+	oldValue, removed = v.forward[key]
+	if !removed {
+		return
+	}
+	defer v.applyMod()
+	delete(v.forward, key)
+	delete(v.backward, oldValue)
+	return
+}
+
+func (v *BiMap[K, V]) RemoveAll(keys coll.Collection[K]) {
+	coll.ForEach(keys.Iterator(), func(i K) bool {
+		v.Remove(i)
+		return true
+	})
+}
+
+func (v *BiMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	return GetOrDefault[K, V](v, key, defaultValue)
+}
+
+func (v *BiMap[K, V]) PutIfAbsent(key K, value V) (previous V, present bool) {
+	return PutIfAbsent[K, V](v, key, value)
+}
+
+func (v *BiMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return ComputeIfAbsent[K, V](v, key, mapping)
+}
+
+func (v *BiMap[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	return ComputeIfPresent[K, V](v, key, remapping)
+}
+
+func (v *BiMap[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	return Compute[K, V](v, key, remapping)
+}
+
+func (v *BiMap[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	return Merge[K, V](v, key, value, remapping)
+}
+
+func (v *BiMap[K, V]) Replace(key K, value V) (oldValue V, replaced bool) {
+	return Replace[K, V](v, key, value)
+}
+
+// Replace every value with the one returned by function. The new values are checked before any is applied,
+// so values can be swapped. Panics if two keys get the same value, leaving the map unchanged
+func (v *BiMap[K, V]) ReplaceAll(function func(key K, value V) V) {
+	replaced := make(map[K]V, len(v.forward))
+	inverse := make(map[V]K, len(v.forward))
+	for key, value := range v.forward {
+		newValue := function(key, value)
+		if _, ok := inverse[newValue]; ok {
+			panic("Value already present in BiMap, ReplaceAll must keep values unique")
+		}
+		replaced[key] = newValue
+		inverse[newValue] = key
+	}
+	defer v.applyMod()
+	for value := range v.backward {
+		delete(v.backward, value)
+	}
+	for key, value := range replaced {
+		v.forward[key] = value
+		v.backward[value] = key
+	}
+}
+
+func (v *BiMap[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	return ForEach[K, V](v, visitor)
+}
+
+func (v *BiMap[K, V]) Keys() set.Set[K] {
+	result := set.NewHashSet[K]()
+	for key := range v.forward {
+		result.Add(key)
+	}
+	return result
+}
+
+func (v *BiMap[K, V]) Iterator() coll.Iterator[KV[K, V]] {
+	return &BiMapIterator[K, V]{Src: v, generation: *v.generation, lastIndex: -1, keys: readKeys(v.forward)}
+}
+
+func (v *BiMap[K, V]) Stream() stream.Stream[KV[K, V]] {
+	return stream.FromIterator[KV[K, V]](v.Iterator())
+}
+
+// Iterates a snapshot of the keys, values are read in realtime
+type BiMapIterator[K comparable, V comparable] struct {
+	Src          *BiMap[K, V]
+	generation   int
+	currentIndex int
+	lastIndex    int
+	keys         []K
+}
+
+func (v *BiMapIterator[K, V]) checkMod() {
+	if v.generation != *v.Src.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *BiMapIterator[K, V]) Next() (result KV[K, V], ok bool) {
+	v.checkMod()
+	if v.currentIndex >= len(v.keys) {
+		return result, false
+	}
+	key := v.keys[v.currentIndex]
+	v.currentIndex++
+	v.lastIndex = v.currentIndex - 1
+	return KVOf(key, v.Src.forward[key]), true
+}
+
+func (v *BiMapIterator[K, V]) Remove() {
+	v.checkMod()
+	if v.lastIndex == -1 {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	v.Src.Remove(v.keys[v.lastIndex])
+	v.lastIndex = -1
+	v.generation = *v.Src.generation
+}
+
+// Replace the value of the last entry. Panics if the new value belongs to another key
+func (v *BiMapIterator[K, V]) Set(data KV[K, V]) KV[K, V] {
+	v.checkMod()
+	if v.lastIndex == -1 {
+		panic("Don't call set before reading")
+	}
+	key := v.keys[v.lastIndex]
+	if key != data.Key() {
+		panic("Map iterator.Set must set the same key!")
+	}
+	old := KVOf(key, v.Src.forward[key])
+	v.Src.Put(key, data.Value())
+	v.generation = *v.Src.generation
+	return old
+}
+
+// Returns an empty BiMap backed by two Go maps
+func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
+	generation := 0
+	return &BiMap[K, V]{forward: make(map[K]V), backward: make(map[V]K), generation: &generation}
+}
@@ -0,0 +1,78 @@
+package Map
+
+import (
+	"testing"
+
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/gojava/common"
+)
+
+var _ Map[string, int] = NewBiMap[string, int]()
+
+func TestBiMap(t *testing.T) {
+	codes := NewBiMap[string, int]()
+	codes.Put("SG", 65)
+	codes.Put("US", 1)
+	codes.Put("SG", 65)
+	common.AssertEq(t, codes.Size(), 2)
+	key, ok := codes.GetKey(65)
+	common.AssertTrue(t, ok)
+	common.AssertEq(t, key, "SG")
+	common.AssertTrue(t, codes.ContainsValue(1))
+
+	assertPanics(t, func() { codes.Put("CA", 1) })
+	common.AssertFalse(t, codes.Contains("CA"))
+
+	codes.ForcePut("CA", 1)
+	common.AssertFalse(t, codes.Contains("US"))
+	common.AssertEq(t, codes.Inverse().GetOrDefault(1, ""), "CA")
+
+	codes.Put("SG", 6565)
+	common.AssertFalse(t, codes.ContainsValue(65))
+	common.AssertEq(t, codes.Size(), 2)
+
+	codes.Remove("SG")
+	common.AssertFalse(t, codes.Inverse().Contains(6565))
+	codes.Clear()
+	common.AssertEq(t, codes.Inverse().Size(), 0)
+}
+
+func TestBiMapInverse(t *testing.T) {
+	ids := NewBiMap[string, int]()
+	inverse := ids.Inverse()
+	common.AssertTrue(t, inverse.Inverse() == ids)
+
+	inverse.Put(1, "a")
+	inverse.Put(2, "b")
+	common.AssertEq(t, ids.GetOrDefault("b", 0), 2)
+	ids.Put("c", 3)
+	common.AssertEq(t, inverse.GetOrDefault(3, ""), "c")
+
+	inverse.KeySet().RemoveAll(list.ArrayListOf(1))
+	common.AssertFalse(t, ids.Contains("a"))
+
+	iter := ids.Iterator()
+	iter.Next()
+	inverse.Put(4, "d")
+	assertPanics(t, func() { iter.Next() })
+
+	ids.ReplaceAll(func(key string, value int) int {
+		return value * 10
+	})
+	common.AssertEq(t, inverse.GetOrDefault(20, ""), "b")
+	common.AssertEq(t, ids.Values().Size(), 3)
+
+	// Values can be permuted, the new values are checked as a whole
+	ids.ReplaceAll(func(key string, value int) int {
+		return 60 - value
+	})
+	common.AssertEq(t, ids.GetOrDefault("b", 0), 40)
+	common.AssertEq(t, inverse.GetOrDefault(20, ""), "d")
+	assertPanics(t, func() {
+		ids.ReplaceAll(func(key string, value int) int {
+			return 1
+		})
+	})
+	common.AssertEq(t, ids.GetOrDefault("b", 0), 40)
+	common.AssertEq(t, inverse.Size(), 3)
+}
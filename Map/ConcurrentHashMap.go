@@ -0,0 +1,298 @@
+package Map
+
+import (
+	"sync"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+type concurrentSegment[K comparable, V any] struct {
+	lock sync.RWMutex
+	data map[K]V
+}
+
+// A lock striped hash map that is safe for concurrent use by multiple goroutines.
+// Keys are spread over segments, each guarded by its own lock, so operations on different segments don't block each other.
+// Single key operations are atomic. Size(), PutAll(), RemoveAll() and the iterators are not atomic across segments.
+type ConcurrentHashMap[K comparable, V any] struct {
+	segments []*concurrentSegment[K, V]
+}
+
+func (v *ConcurrentHashMap[K, V]) segmentFor(key K) *concurrentSegment[K, V] {
+	return v.segments[hashOf(key)%uint64(len(v.segments))]
+}
+
+func (v *ConcurrentHashMap[K, V]) Size() int {
+	result := 0
+	for _, segment := range v.segments {
+		segment.lock.RLock()
+		result += len(segment.data)
+		segment.lock.RUnlock()
+	}
+	return result
+}
+
+func (v *ConcurrentHashMap[K, V]) IsEmpty() bool {
+	return v.Size() == 0
+}
+
+func (v *ConcurrentHashMap[K, V]) Contains(key K) bool {
+	_, ok := v.Get(key)
+	return ok
+}
+
+func (v *ConcurrentHashMap[K, V]) Get(key K) (result V, ok bool) {
+	segment := v.segmentFor(key)
+	segment.lock.RLock()
+	defer segment.lock.RUnlock()
+	result, ok = segment.data[key]
+	return
+}
+
+func (v *ConcurrentHashMap[K, V]) Put(key K, value V) {
+	segment := v.segmentFor(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	segment.data[key] = value
+}
+
+func (v *ConcurrentHashMap[K, V]) PutAll(other Map[K, V]) {
+	coll.ForEach(
+		other.Iterator(),
+		func(i KV[K, V]) bool {
+			v.Put(i.Key(), i.Value())
+			return true
+		})
+}
+
+func (v *ConcurrentHashMap[K, V]) Remove(key K) (oldValue V, removed bool) {
+	segment := v.segmentFor(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	oldValue, removed = segment.data[key]
+	delete(segment.data, key)
+	return
+}
+
+func (v *ConcurrentHashMap[K, V]) RemoveAll(keys coll.Collection[K]) {
+	coll.ForEach(keys.Iterator(), func(i K) bool {
+		v.Remove(i)
+		return true
+	})
+}
+
+func (v *ConcurrentHashMap[K, V]) Clear() {
+	for _, segment := range v.segments {
+		segment.lock.Lock()
+		segment.data = make(map[K]V)
+		segment.lock.Unlock()
+	}
+}
+
+func (v *ConcurrentHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	return GetOrDefault[K, V](v, key, defaultValue)
+}
+
+// If key is absent, put value. Otherwise leave the map unchanged.
+// Returns the value that was present, and whether there was one
+func (v *ConcurrentHashMap[K, V]) PutIfAbsent(key K, value V) (previous V, present bool) {
+	segment := v.segmentFor(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	previous, present = segment.data[key]
+	if !present {
+		segment.data[key] = value
+	}
+	return
+}
+
+// If key is absent, put the value returned by mapping. Returns the current value for key.
+// mapping is called at most once, while the segment is locked, so it must not access this map
+func (v *ConcurrentHashMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	segment := v.segmentFor(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	if current, ok := segment.data[key]; ok {
+		return current
+	}
+	result := mapping(key)
+	segment.data[key] = result
+	return result
+}
+
+// If key is present, replace its value with the one returned by remapping. When remapping returns keep == false,
+// the key is removed. Returns the new value and whether key is present afterwards.
+// remapping is called while the segment is locked, so it must not access this map
+func (v *ConcurrentHashMap[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	segment := v.segmentFor(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	current, ok := segment.data[key]
+	if !ok {
+		return
+	}
+	newValue, keep := remapping(key, current)
+	return v.store(segment, key, newValue, keep)
+}
+
+// Compute a new value for key from its current value (exists is false if key is absent). When remapping returns
+// keep == false, the key is removed. Returns the new value and whether key is present afterwards.
+// remapping is called while the segment is locked, so it must not access this map
+func (v *ConcurrentHashMap[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	segment := v.segmentFor(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	current, ok := segment.data[key]
+	newValue, keep := remapping(key, current, ok)
+	return v.store(segment, key, newValue, keep)
+}
+
+// If key is absent, put value. Otherwise replace the current value with remapping(current, value).
+// When remapping returns keep == false, the key is removed. Returns the new value and whether key is present afterwards.
+// remapping is called while the segment is locked, so it must not access this map
+func (v *ConcurrentHashMap[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	segment := v.segmentFor(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	current, ok := segment.data[key]
+	if !ok {
+		segment.data[key] = value
+		return value, true
+	}
+	newValue, keep := remapping(current, value)
+	return v.store(segment, key, newValue, keep)
+}
+
+func (v *ConcurrentHashMap[K, V]) store(segment *concurrentSegment[K, V], key K, value V, keep bool) (V, bool) {
+	if !keep {
+		delete(segment.data, key)
+		var zv V
+		return zv, false
+	}
+	segment.data[key] = value
+	return value, true
+}
+
+// Replace the value only if key is present. Returns the old value and whether it was replaced
+func (v *ConcurrentHashMap[K, V]) Replace(key K, value V) (oldValue V, replaced bool) {
+	segment := v.segmentFor(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	oldValue, replaced = segment.data[key]
+	if replaced {
+		segment.data[key] = value
+	}
+	return
+}
+
+// Replace the value only if key is currently mapped to oldValue (compared with the default equalizer).
+// Returns whether it was replaced
+func (v *ConcurrentHashMap[K, V]) ReplaceIf(key K, oldValue V, newValue V) bool {
+	return v.ReplaceIfFunc(key, oldValue, newValue, coll.DefaultEqualizer[V]())
+}
+
+// Same as ReplaceIf, but compares values with equals
+func (v *ConcurrentHashMap[K, V]) ReplaceIfFunc(key K, oldValue V, newValue V, equals coll.Equalizer[V]) bool {
+	segment := v.segmentFor(key)
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	current, ok := segment.data[key]
+	if !ok || !equals(current, oldValue) {
+		return false
+	}
+	segment.data[key] = newValue
+	return true
+}
+
+// Replace every value with the one returned by function. Each segment is replaced under its lock,
+// so function must not access this map
+func (v *ConcurrentHashMap[K, V]) ReplaceAll(function func(key K, value V) V) {
+	for _, segment := range v.segments {
+		segment.lock.Lock()
+		for key, value := range segment.data {
+			segment.data[key] = function(key, value)
+		}
+		segment.lock.Unlock()
+	}
+}
+
+// Visit each entry with the weakly consistent iterator. When visitor returns false, it stops
+func (v *ConcurrentHashMap[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	return ForEach[K, V](v, visitor)
+}
+
+func (v *ConcurrentHashMap[K, V]) ContainsValue(what V) bool {
+	return v.ContainsValueFunc(what, coll.DefaultEqualizer[V]())
+}
+
+func (v *ConcurrentHashMap[K, V]) ContainsValueFunc(what V, equals coll.Equalizer[V]) bool {
+	return v.Values().ContainsFunc(what, equals)
+}
+
+// Snapshot of the keys. Each segment is copied under its lock
+func (v *ConcurrentHashMap[K, V]) Keys() set.Set[K] {
+	result := set.NewHashSet[K]()
+	for _, segment := range v.segments {
+		segment.lock.RLock()
+		for key := range segment.data {
+			result.Add(key)
+		}
+		segment.lock.RUnlock()
+	}
+	return result
+}
+
+// Snapshot of the values. Each segment is copied under its lock
+func (v *ConcurrentHashMap[K, V]) Values() coll.Collection[V] {
+	result := list.NewLinkedList[V]()
+	for _, segment := range v.segments {
+		segment.lock.RLock()
+		for _, value := range segment.data {
+			result.Add(value)
+		}
+		segment.lock.RUnlock()
+	}
+	return result
+}
+
+func (v *ConcurrentHashMap[K, V]) KeySet() set.Set[K] {
+	return KeySet[K, V](v)
+}
+
+func (v *ConcurrentHashMap[K, V]) ValuesView() coll.Collection[V] {
+	return ValuesView[K, V](v)
+}
+
+func (v *ConcurrentHashMap[K, V]) EntrySet() coll.Collection[Entry[K, V]] {
+	return EntrySet[K, V](v)
+}
+
+// Returns a weakly consistent iterator. See ConcurrentHashMapIterator
+func (v *ConcurrentHashMap[K, V]) Iterator() coll.Iterator[KV[K, V]] {
+	return NewConcurrentHashMapIteratorFor(v)
+}
+
+func (v *ConcurrentHashMap[K, V]) Stream() stream.Stream[KV[K, V]] {
+	return stream.FromIterator[KV[K, V]](v.Iterator())
+}
+
+// Return new empty ConcurrentHashMap[K, V] with 16 segments
+func NewConcurrentHashMap[K comparable, V any]() *ConcurrentHashMap[K, V] {
+	return NewConcurrentHashMapWithSegments[K, V](16)
+}
+
+// Return new empty ConcurrentHashMap[K, V]. segments is the number of locks, roughly the number of
+// goroutines expected to write at the same time
+func NewConcurrentHashMapWithSegments[K comparable, V any](segments int) *ConcurrentHashMap[K, V] {
+	if segments < 1 {
+		segments = 1
+	}
+	result := &ConcurrentHashMap[K, V]{segments: make([]*concurrentSegment[K, V], segments)}
+	for i := range result.segments {
+		result.segments[i] = &concurrentSegment[K, V]{data: make(map[K]V)}
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package Map
+
+import coll "github.com/wushilin/gojava/Collection"
+
+// A weakly consistent iterator. Keys of a segment are copied when the iterator reaches that segment,
+// values are read when returned. Keys removed after the copy are skipped, keys added after the copy
+// might not be visited. It never panics because of concurrent modification.
+type ConcurrentHashMapIterator[K comparable, V any] struct {
+	Src          *ConcurrentHashMap[K, V]
+	segmentIndex int
+	keys         []K
+	currentIndex int
+	lastKey      K
+	hasLast      bool
+}
+
+func (v *ConcurrentHashMapIterator[K, V]) loadSegment() {
+	segment := v.Src.segments[v.segmentIndex]
+	segment.lock.RLock()
+	defer segment.lock.RUnlock()
+	v.keys = readKeys(segment.data)
+	v.currentIndex = 0
+	v.segmentIndex++
+}
+
+func (v *ConcurrentHashMapIterator[K, V]) Next() (result KV[K, V], ok bool) {
+	for {
+		for v.currentIndex < len(v.keys) {
+			key := v.keys[v.currentIndex]
+			v.currentIndex++
+			if value, found := v.Src.Get(key); found {
+				v.lastKey = key
+				v.hasLast = true
+				return KVOf(key, value), true
+			}
+		}
+		if v.segmentIndex >= len(v.Src.segments) {
+			return result, false
+		}
+		v.loadSegment()
+	}
+}
+
+func (v *ConcurrentHashMapIterator[K, V]) Remove() {
+	if !v.hasLast {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	v.hasLast = false
+	v.Src.Remove(v.lastKey)
+}
+
+// Puts the new value for the last returned key, even if it was removed in the mean time
+func (v *ConcurrentHashMapIterator[K, V]) Set(data KV[K, V]) KV[K, V] {
+	if !v.hasLast {
+		panic("Don't call set before reading")
+	}
+	if v.lastKey != data.Key() {
+		panic("Map iterator.Set must set the same key!")
+	}
+	segment := v.Src.segmentFor(data.Key())
+	segment.lock.Lock()
+	defer segment.lock.Unlock()
+	old := segment.data[data.Key()]
+	segment.data[data.Key()] = data.Value()
+	return KVOf(data.Key(), old)
+}
+
+func NewConcurrentHashMapIteratorFor[K comparable, V any](v *ConcurrentHashMap[K, V]) coll.Iterator[KV[K, V]] {
+	return &ConcurrentHashMapIterator[K, V]{Src: v}
+}
@@ -0,0 +1,93 @@
+package Map
+
+import (
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+type point struct {
+	x, y int
+	name string
+}
+
+func TestConcurrentHashMap(t *testing.T) {
+	mp := NewConcurrentHashMap[int, int]()
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				mp.Merge(i, 1, func(old, value int) (int, bool) {
+					return old + value, true
+				})
+				mp.ComputeIfAbsent(-i-1, func(key int) int {
+					return key * 2
+				})
+				// Iterating while other goroutines write must not panic
+				if i%100 == 0 {
+					iter := mp.Iterator()
+					for _, ok := iter.Next(); ok; _, ok = iter.Next() {
+					}
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	common.AssertEq(t, mp.Size(), 2000)
+	for i := 0; i < 1000; i++ {
+		value, _ := mp.Get(i)
+		common.AssertEq(t, value, 8)
+		value, _ = mp.Get(-i - 1)
+		common.AssertEq(t, value, (-i-1)*2)
+	}
+
+	previous, present := mp.PutIfAbsent(1, 100)
+	common.AssertTrue(t, present)
+	common.AssertEq(t, previous, 8)
+	_, present = mp.PutIfAbsent(5000, 100)
+	common.AssertFalse(t, present)
+
+	common.AssertFalse(t, mp.ReplaceIf(1, 7, 9))
+	common.AssertTrue(t, mp.ReplaceIf(1, 8, 9))
+	old, replaced := mp.Replace(1, 10)
+	common.AssertTrue(t, replaced)
+	common.AssertEq(t, old, 9)
+	_, replaced = mp.Replace(6000, 10)
+	common.AssertFalse(t, replaced)
+
+	_, present = mp.ComputeIfPresent(1, func(key, old int) (int, bool) {
+		return 0, false
+	})
+	common.AssertFalse(t, present)
+	common.AssertFalse(t, mp.Contains(1))
+	result, _ := mp.Compute(1, func(key, old int, exists bool) (int, bool) {
+		common.AssertFalse(t, exists)
+		return 42, true
+	})
+	common.AssertEq(t, result, 42)
+
+	iter := mp.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if next.Key() < 0 {
+			iter.Remove()
+		}
+		mp.Put(next.Key()+10000, 0)
+	}
+	common.AssertFalse(t, mp.Contains(-1))
+}
+
+func TestConcurrentHashMapStructKeys(t *testing.T) {
+	mp := NewConcurrentHashMapWithSegments[point, string](4)
+	mp.Put(point{1, 2, "a"}, "first")
+	mp.Put(point{1, 2, "a"}, "second")
+	mp.Put(point{2, 1, "a"}, "third")
+	common.AssertEq(t, mp.Size(), 2)
+	value, _ := mp.Get(point{1, 2, "a"})
+	common.AssertEq(t, value, "second")
+	common.AssertEq(t, hashOf(point{1, 2, "a"}), hashOf(point{1, 2, "a"}))
+	common.AssertEq(t, hashOf(0.0), hashOf(math.Copysign(0, -1)))
+}
@@ -0,0 +1,209 @@
+package Map
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+// A hash map that uses the given Hasher and Equalizer for keys instead of ==, so keys don't need to be comparable.
+// Keys can be slices, structs with slice fields, or strings compared ignoring case.
+// Entries with the same hash share a bucket and are told apart by the Equalizer.
+// It has the basic methods of Map, but is not a Map, because Map requires comparable keys
+type CustomHashMap[K any, V any] struct {
+	buckets    map[uint64][]*MapKV[K, V]
+	size       int
+	hasher     coll.Hasher[K]
+	equals     coll.Equalizer[K]
+	generation int
+}
+
+// Return an empty CustomHashMap. Keys equal by equals must have the same hash by hasher.
+// A nil hasher or equals falls back to DefaultHasher and DefaultEqualizer, which use HashCode() and Equals() when available
+func NewCustomHashMap[K any, V any](hasher coll.Hasher[K], equals coll.Equalizer[K]) *CustomHashMap[K, V] {
+	if hasher == nil {
+		hasher = coll.DefaultHasher[K]()
+	}
+	if equals == nil {
+		equals = coll.DefaultEqualizer[K]()
+	}
+	return &CustomHashMap[K, V]{buckets: make(map[uint64][]*MapKV[K, V]), hasher: hasher, equals: equals}
+}
+
+func (v *CustomHashMap[K, V]) applyMod() {
+	v.generation++
+}
+
+// The entry for key, nil if not found
+func (v *CustomHashMap[K, V]) find(hash uint64, key K) *MapKV[K, V] {
+	for _, next := range v.buckets[hash] {
+		if v.equals(next.key, key) {
+			return next
+		}
+	}
+	return nil
+}
+
+func (v *CustomHashMap[K, V]) Size() int {
+	return v.size
+}
+
+func (v *CustomHashMap[K, V]) IsEmpty() bool {
+	return v.size == 0
+}
+
+func (v *CustomHashMap[K, V]) Contains(key K) bool {
+	return v.find(v.hasher(key), key) != nil
+}
+
+func (v *CustomHashMap[K, V]) Get(key K) (result V, ok bool) {
+	entry := v.find(v.hasher(key), key)
+	if entry == nil {
+		return
+	}
+	return entry.value, true
+}
+
+func (v *CustomHashMap[K, V]) Put(key K, value V) {
+	defer v.applyMod()
+	hash := v.hasher(key)
+	if entry := v.find(hash, key); entry != nil {
+		entry.value = value
+		return
+	}
+	v.buckets[hash] = append(v.buckets[hash], &MapKV[K, V]{key: key, value: value})
+	v.size++
+}
+
+func (v *CustomHashMap[K, V]) PutAll(other *CustomHashMap[K, V]) {
+	coll.ForEach(other.Iterator(), func(i KV[K, V]) bool {
+		v.Put(i.Key(), i.Value())
+		return true
+	})
+}
+
+func (v *CustomHashMap[K, V]) Remove(key K) (oldValue V, removed bool) {
+	hash := v.hasher(key)
+	bucket := v.buckets[hash]
+	for index, next := range bucket {
+		if !v.equals(next.key, key) {
+			continue
+		}
+		defer v.applyMod()
+		if len(bucket) == 1 {
+			delete(v.buckets, hash)
+		} else {
+			rest := make([]*MapKV[K, V], 0, len(bucket)-1)
+			rest = append(rest, bucket[:index]...)
+			v.buckets[hash] = append(rest, bucket[index+1:]...)
+		}
+		v.size--
+		return next.value, true
+	}
+	return
+}
+
+func (v *CustomHashMap[K, V]) RemoveAll(keys coll.Collection[K]) {
+	coll.ForEach(keys.Iterator(), func(i K) bool {
+		v.Remove(i)
+		return true
+	})
+}
+
+func (v *CustomHashMap[K, V]) Clear() {
+	defer v.applyMod()
+	v.buckets = make(map[uint64][]*MapKV[K, V])
+	v.size = 0
+}
+
+func (v *CustomHashMap[K, V]) ContainsValue(what V) bool {
+	return v.ContainsValueFunc(what, coll.DefaultEqualizer[V]())
+}
+
+func (v *CustomHashMap[K, V]) ContainsValueFunc(what V, equals coll.Equalizer[V]) bool {
+	return v.Values().ContainsFunc(what, equals)
+}
+
+// Returns a snapshot of the keys, as a CustomHashSet with the same Hasher and Equalizer
+func (v *CustomHashMap[K, V]) Keys() *set.CustomHashSet[K] {
+	result := set.NewCustomHashSet(v.hasher, v.equals)
+	for _, bucket := range v.buckets {
+		for _, next := range bucket {
+			result.Add(next.key)
+		}
+	}
+	return result
+}
+
+func (v *CustomHashMap[K, V]) Values() coll.Collection[V] {
+	result := list.NewArrayList[V]()
+	for _, bucket := range v.buckets {
+		for _, next := range bucket {
+			result.Add(next.value)
+		}
+	}
+	return result
+}
+
+// Iterates a snapshot of the keys, values are read in realtime
+func (v *CustomHashMap[K, V]) Iterator() coll.Iterator[KV[K, V]] {
+	entries := make([]*MapKV[K, V], 0, v.size)
+	for _, bucket := range v.buckets {
+		entries = append(entries, bucket...)
+	}
+	return &CustomHashMapIterator[K, V]{Src: v, generation: v.generation, lastIndex: -1, entries: entries}
+}
+
+func (v *CustomHashMap[K, V]) Stream() stream.Stream[KV[K, V]] {
+	return stream.FromIterator[KV[K, V]](v.Iterator())
+}
+
+type CustomHashMapIterator[K any, V any] struct {
+	Src          *CustomHashMap[K, V]
+	generation   int
+	currentIndex int
+	lastIndex    int
+	entries      []*MapKV[K, V]
+}
+
+func (v *CustomHashMapIterator[K, V]) checkMod() {
+	if v.generation != v.Src.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *CustomHashMapIterator[K, V]) Next() (result KV[K, V], ok bool) {
+	v.checkMod()
+	if v.currentIndex >= len(v.entries) {
+		return result, false
+	}
+	entry := v.entries[v.currentIndex]
+	v.currentIndex++
+	v.lastIndex = v.currentIndex - 1
+	return KVOf(entry.key, entry.value), true
+}
+
+func (v *CustomHashMapIterator[K, V]) Remove() {
+	v.checkMod()
+	if v.lastIndex == -1 {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	v.Src.Remove(v.entries[v.lastIndex].key)
+	v.lastIndex = -1
+	v.generation = v.Src.generation
+}
+
+func (v *CustomHashMapIterator[K, V]) Set(data KV[K, V]) KV[K, V] {
+	v.checkMod()
+	if v.lastIndex == -1 {
+		panic("Don't call set before reading")
+	}
+	entry := v.entries[v.lastIndex]
+	if !v.Src.equals(entry.key, data.Key()) {
+		panic("Map iterator.Set must set the same key!")
+	}
+	old := KVOf(entry.key, entry.value)
+	entry.value = data.Value()
+	return old
+}
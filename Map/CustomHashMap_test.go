@@ -0,0 +1,63 @@
+package Map
+
+import (
+	"strings"
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/gojava/common"
+)
+
+func TestCustomHashMap(t *testing.T) {
+	m := NewCustomHashMap[[]string, int](coll.DefaultHasher[[]string](), coll.DefaultEqualizer[[]string]())
+	m.Put([]string{"a", "b"}, 1)
+	m.Put([]string{"a", "b"}, 2)
+	m.Put([]string{"b", "a"}, 3)
+	common.AssertEq(t, m.Size(), 2)
+	value, ok := m.Get([]string{"a", "b"})
+	common.AssertTrue(t, ok)
+	common.AssertEq(t, value, 2)
+	common.AssertTrue(t, m.ContainsValue(3))
+	common.AssertTrue(t, m.Keys().Contains([]string{"b", "a"}))
+	m.Remove([]string{"a", "b"})
+	common.AssertFalse(t, m.Contains([]string{"a", "b"}))
+	common.AssertEq(t, m.Size(), 1)
+
+	lower := strings.ToLower
+	headers := NewCustomHashMap[string, string](coll.HashingBy(lower), coll.EqualizingBy(lower))
+	headers.Put("Content-Type", "text/plain")
+	headers.Put("content-type", "text/html")
+	common.AssertEq(t, headers.Size(), 1)
+	value2, _ := headers.Get("CONTENT-TYPE")
+	common.AssertEq(t, value2, "text/html")
+
+	iter := headers.Iterator()
+	entry, _ := iter.Next()
+	iter.Set(KVOf("CONTENT-type", "application/json"))
+	value2, _ = headers.Get(entry.Key())
+	common.AssertEq(t, value2, "application/json")
+	assertPanics(t, func() { iter.Set(KVOf("Accept", "")) })
+	iter.Remove()
+	common.AssertTrue(t, headers.IsEmpty())
+
+	headers.Put("a", "1")
+	headers.Put("B", "2")
+	headers.RemoveAll(list.ArrayListOf("A", "b"))
+	common.AssertTrue(t, headers.IsEmpty())
+}
+
+func TestCustomHashMapCollisions(t *testing.T) {
+	m := NewCustomHashMap[int, int](func(i int) uint64 { return uint64(i % 3) }, coll.DefaultEqualizer[int]())
+	for i := 0; i < 300; i++ {
+		m.Put(i, i*i)
+	}
+	for i := 0; i < 300; i += 3 {
+		m.Remove(i)
+	}
+	common.AssertEq(t, m.Size(), 200)
+	common.AssertEq(t, m.Stream().Count(), 200)
+	value, _ := m.Get(10)
+	common.AssertEq(t, value, 100)
+	common.AssertFalse(t, m.Contains(9))
+}
@@ -0,0 +1,102 @@
+package Map
+
+// Default implementations of the Map methods that are built on Get, Put, Remove and Iterator.
+// A Map implementation can delegate to them, or provide something faster or atomic instead
+
+// Return the value for key, or defaultValue if key is absent
+func GetOrDefault[K comparable, V any](m Map[K, V], key K, defaultValue V) V {
+	if value, ok := m.Get(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// If key is absent, put value. Returns the value that was present, and whether there was one
+func PutIfAbsent[K comparable, V any](m Map[K, V], key K, value V) (previous V, present bool) {
+	previous, present = m.Get(key)
+	if !present {
+		m.Put(key, value)
+	}
+	return
+}
+
+// If key is absent, put the value returned by mapping. Returns the current value for key
+func ComputeIfAbsent[K comparable, V any](m Map[K, V], key K, mapping func(key K) V) V {
+	if current, ok := m.Get(key); ok {
+		return current
+	}
+	result := mapping(key)
+	m.Put(key, result)
+	return result
+}
+
+// If key is present, replace its value with the one returned by remapping, or remove key when remapping
+// returns keep == false. Returns the new value and whether key is present afterwards
+func ComputeIfPresent[K comparable, V any](m Map[K, V], key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	current, ok := m.Get(key)
+	if !ok {
+		return
+	}
+	newValue, keep := remapping(key, current)
+	return store(m, key, newValue, keep)
+}
+
+// Compute a new value for key from its current value (exists is false if key is absent), or remove key when
+// remapping returns keep == false. Returns the new value and whether key is present afterwards
+func Compute[K comparable, V any](m Map[K, V], key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	current, ok := m.Get(key)
+	newValue, keep := remapping(key, current, ok)
+	return store(m, key, newValue, keep)
+}
+
+// If key is absent, put value. Otherwise replace the current value with remapping(current, value), or remove key
+// when remapping returns keep == false. Returns the new value and whether key is present afterwards
+func Merge[K comparable, V any](m Map[K, V], key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	current, ok := m.Get(key)
+	if !ok {
+		m.Put(key, value)
+		return value, true
+	}
+	newValue, keep := remapping(current, value)
+	return store(m, key, newValue, keep)
+}
+
+func store[K comparable, V any](m Map[K, V], key K, value V, keep bool) (V, bool) {
+	if !keep {
+		m.Remove(key)
+		var zv V
+		return zv, false
+	}
+	m.Put(key, value)
+	return value, true
+}
+
+// Replace the value only if key is present. Returns the old value and whether it was replaced
+func Replace[K comparable, V any](m Map[K, V], key K, value V) (oldValue V, replaced bool) {
+	oldValue, replaced = m.Get(key)
+	if replaced {
+		m.Put(key, value)
+	}
+	return
+}
+
+// Replace every value with the one returned by function, through the iterator
+func ReplaceAll[K comparable, V any](m Map[K, V], function func(key K, value V) V) {
+	iter := m.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		iter.Set(KVOf(next.Key(), function(next.Key(), next.Value())))
+	}
+}
+
+// Visit each entry. When visitor returns false, it stops. Returns the number of entries visited
+func ForEach[K comparable, V any](m Map[K, V], visitor func(key K, value V) bool) int {
+	count := 0
+	iter := m.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		count++
+		if !visitor(next.Key(), next.Value()) {
+			break
+		}
+	}
+	return count
+}
@@ -0,0 +1,90 @@
+package Map
+
+import (
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/common"
+)
+
+func assertPanics(t *testing.T, f func()) {
+	defer func() {
+		common.AssertTrue(t, recover() != nil)
+	}()
+	f()
+	t.Fatal("Expect panic")
+}
+
+func TestDefaultMethods(t *testing.T) {
+	maps := map[string]Map[string, int]{
+		"HashMap":           NewHashMap[string, int](),
+		"LinkedHashMap":     NewLinkedHashMap[string, int](),
+		"TreeMap":           NewTreeMap[string, int](coll.NaturalOrder[string]()),
+		"TreeMap.TailMap":   NewTreeMap[string, int](coll.NaturalOrder[string]()).TailMap("", true),
+		"ConcurrentHashMap": NewConcurrentHashMap[string, int](),
+	}
+	for name, m := range maps {
+		t.Run(name, func(t *testing.T) {
+			for _, word := range []string{"a", "b", "a", "c", "a"} {
+				m.Merge(word, 1, func(old, one int) (int, bool) {
+					return old + one, true
+				})
+			}
+			common.AssertEq(t, m.GetOrDefault("a", 0), 3)
+			common.AssertEq(t, m.GetOrDefault("z", -1), -1)
+
+			previous, present := m.PutIfAbsent("b", 10)
+			common.AssertTrue(t, present)
+			common.AssertEq(t, previous, 1)
+			_, present = m.PutIfAbsent("d", 4)
+			common.AssertFalse(t, present)
+
+			common.AssertEq(t, m.ComputeIfAbsent("e", func(key string) int { return 5 }), 5)
+			common.AssertEq(t, m.ComputeIfAbsent("e", func(key string) int { return 50 }), 5)
+
+			_, present = m.ComputeIfPresent("e", func(key string, old int) (int, bool) { return 0, false })
+			common.AssertFalse(t, present)
+			common.AssertFalse(t, m.Contains("e"))
+
+			result, present := m.Compute("f", func(key string, old int, exists bool) (int, bool) {
+				common.AssertFalse(t, exists)
+				return 6, true
+			})
+			common.AssertTrue(t, present)
+			common.AssertEq(t, result, 6)
+
+			_, replaced := m.Replace("g", 7)
+			common.AssertFalse(t, replaced)
+			old, replaced := m.Replace("f", 60)
+			common.AssertTrue(t, replaced)
+			common.AssertEq(t, old, 6)
+
+			m.ReplaceAll(func(key string, value int) int { return value * 2 })
+			common.AssertEq(t, m.GetOrDefault("a", 0), 6)
+
+			sum := 0
+			visited := m.ForEach(func(key string, value int) bool {
+				sum += value
+				return true
+			})
+			common.AssertEq(t, visited, m.Size())
+			common.AssertEq(t, sum, (3+1+1+4+60)*2)
+			common.AssertEq(t, m.ForEach(func(key string, value int) bool { return false }), 1)
+
+			removed, ok := m.Remove("a")
+			common.AssertTrue(t, ok)
+			common.AssertEq(t, removed, 6)
+			_, ok = m.Remove("a")
+			common.AssertFalse(t, ok)
+		})
+	}
+}
+
+func TestDefaultMethodsImmutable(t *testing.T) {
+	m := MapOf("a", 1)
+	common.AssertEq(t, m.GetOrDefault("b", 2), 2)
+	common.AssertEq(t, m.ForEach(func(key string, value int) bool { return true }), 1)
+	assertPanics(t, func() {
+		m.Merge("a", 1, func(old, value int) (int, bool) { return old + value, true })
+	})
+}
@@ -9,78 +9,6 @@ import (
 	"github.com/wushilin/stream"
 )
 
-// This interface represents a key value pair
-type KV[K comparable, V any] interface {
-	// Return the key of the entry
-	Key() K
-
-	// Return the value of the entry
-	Value() V
-}
-
-type MapKV[K comparable, V any] struct {
-	key   K
-	value V
-}
-
-func (arg *MapKV[K, V]) Key() K {
-	return arg.key
-}
-
-func (arg *MapKV[K, V]) Value() V {
-	return arg.value
-}
-
-// Create a KV from KV value pair
-func KVOf[K comparable, V any](key K, value V) KV[K, V] {
-	return &MapKV[K, V]{key: key, value: value}
-}
-
-// This represents a Map interface
-type Map[K comparable, V any] interface {
-	// Return the count of objects
-	Size() int
-
-	// Test if a key is in the Map
-	Contains(key K) bool
-
-	// Get Value By Key, if no result found, ok is set to false
-	Get(key K) (value V, ok bool)
-
-	// Put Value By Key
-	Put(key K, value V)
-
-	// Put all
-	PutAll(val Map[K, V])
-
-	// Remove by key
-	Remove(key K)
-
-	// Remove all keys
-	RemoveAll(keys coll.Collection[K])
-
-	// Test if a value is found. This is convenient, but inefficient. It iterates the values. You can pass a Equalizer for teting object equality
-	ContainsValueFunc(value V, equals coll.Equalizer[V]) bool
-
-	// Test if a value is found. This is convenient, but inefficient. It iterates the values
-	ContainsValue(value V) bool
-
-	// Create iterator over a snapshot of the key set, but values are read in realtime. Calling Remove() deletes it from the Map
-	// Calling Set(KV[K,V]) deletes the entry from map, but adds new kv to the map. Note that Set iterator's Set Function must set with same key.
-	Iterator() coll.Iterator[KV[K, V]]
-
-	// Return set of  Keys. It will not have duplicates. It is a snapshot, so calling remove for the set does nothing useful for you
-	// Set has no order, and it does not guarantee the order is same as Values()
-	Keys() set.Set[K]
-
-	// Return collection of Values. Duplications might be there. It is a snapshot,  Calling Remove() or Set() does nothing useful for you.
-	// The values has no order.
-	Values() coll.Collection[V]
-
-	// Return stream of KV[K,V]. It uses iterator internally
-	Stream() stream.Stream[KV[K, V]]
-}
-
 type HashMap[K comparable, V any] struct {
 	data       map[K]V
 	generation int
@@ -90,6 +18,10 @@ func (v *HashMap[K, V]) applyMod() {
 	v.generation++
 }
 
+func (v *HashMap[K, V]) modCount() int {
+	return v.generation
+}
+
 func (v *HashMap[K, V]) Size() int {
 	return len(v.data)
 }
@@ -130,6 +62,18 @@ func (v *HashMap[K, V]) Values() coll.Collection[V] {
 	return result
 }
 
+func (v *HashMap[K, V]) KeySet() set.Set[K] {
+	return KeySet[K, V](v)
+}
+
+func (v *HashMap[K, V]) ValuesView() coll.Collection[V] {
+	return ValuesView[K, V](v)
+}
+
+func (v *HashMap[K, V]) EntrySet() coll.Collection[Entry[K, V]] {
+	return EntrySet[K, V](v)
+}
+
 func (v *HashMap[K, V]) PutAll(other Map[K, V]) {
 	coll.ForEach(
 		other.Iterator(),
@@ -138,10 +82,14 @@ func (v *HashMap[K, V]) PutAll(other Map[K, V]) {
 			return true
 		})
 }
-func (v *HashMap[K, V]) Remove(k K) {
+func (v *HashMap[K, V]) Remove(k K) (oldValue V, removed bool) {
+	oldValue, removed = v.data[k]
+	if !removed {
+		return
+	}
 	defer v.applyMod()
 	delete(v.data, k)
-
+	return
 }
 
 func (v *HashMap[K, V]) RemoveAll(keys coll.Collection[K]) {
@@ -150,6 +98,42 @@ func (v *HashMap[K, V]) RemoveAll(keys coll.Collection[K]) {
 		return true
 	})
 }
+
+func (v *HashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	return GetOrDefault[K, V](v, key, defaultValue)
+}
+
+func (v *HashMap[K, V]) PutIfAbsent(key K, value V) (previous V, present bool) {
+	return PutIfAbsent[K, V](v, key, value)
+}
+
+func (v *HashMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return ComputeIfAbsent[K, V](v, key, mapping)
+}
+
+func (v *HashMap[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	return ComputeIfPresent[K, V](v, key, remapping)
+}
+
+func (v *HashMap[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	return Compute[K, V](v, key, remapping)
+}
+
+func (v *HashMap[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	return Merge[K, V](v, key, value, remapping)
+}
+
+func (v *HashMap[K, V]) Replace(key K, value V) (oldValue V, replaced bool) {
+	return Replace[K, V](v, key, value)
+}
+
+func (v *HashMap[K, V]) ReplaceAll(function func(key K, value V) V) {
+	ReplaceAll[K, V](v, function)
+}
+
+func (v *HashMap[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	return ForEach[K, V](v, visitor)
+}
 func (v *HashMap[K, V]) Keys() set.Set[K] {
 	result := set.NewHashSet[K]()
 	for k, _ := range v.data {
@@ -0,0 +1,241 @@
+package Map
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+func immutable() {
+	panic("Unsupported operation: map is immutable")
+}
+
+// A Map that can't be modified after it is created. Methods that modify it panic, including
+// Remove() and Set() of its iterators. It is safe to share across goroutines.
+// Entries are kept in the order they were given. Keys() returns the key set itself, not a copy
+type ImmutableMap[K comparable, V any] struct {
+	keys   *set.ImmutableSet[K]
+	values []V
+}
+
+// Return an ImmutableMap with one entry. MapOf2 to MapOf5 take more pairs,
+// use MapOfEntries or NewImmutableMapBuilder beyond that
+func MapOf[K comparable, V any](key K, value V) *ImmutableMap[K, V] {
+	return MapOfEntries(KVOf(key, value))
+}
+
+// Return an ImmutableMap with two entries. Panics on duplicate keys
+func MapOf2[K comparable, V any](k1 K, v1 V, k2 K, v2 V) *ImmutableMap[K, V] {
+	return MapOfEntries(KVOf(k1, v1), KVOf(k2, v2))
+}
+
+// Return an ImmutableMap with three entries. Panics on duplicate keys
+func MapOf3[K comparable, V any](k1 K, v1 V, k2 K, v2 V, k3 K, v3 V) *ImmutableMap[K, V] {
+	return MapOfEntries(KVOf(k1, v1), KVOf(k2, v2), KVOf(k3, v3))
+}
+
+// Return an ImmutableMap with four entries. Panics on duplicate keys
+func MapOf4[K comparable, V any](k1 K, v1 V, k2 K, v2 V, k3 K, v3 V, k4 K, v4 V) *ImmutableMap[K, V] {
+	return MapOfEntries(KVOf(k1, v1), KVOf(k2, v2), KVOf(k3, v3), KVOf(k4, v4))
+}
+
+// Return an ImmutableMap with five entries. Panics on duplicate keys
+func MapOf5[K comparable, V any](k1 K, v1 V, k2 K, v2 V, k3 K, v3 V, k4 K, v4 V, k5 K, v5 V) *ImmutableMap[K, V] {
+	return MapOfEntries(KVOf(k1, v1), KVOf(k2, v2), KVOf(k3, v3), KVOf(k4, v4), KVOf(k5, v5))
+}
+
+// Return an ImmutableMap with the given entries. Panics on duplicate keys
+func MapOfEntries[K comparable, V any](entries ...KV[K, V]) *ImmutableMap[K, V] {
+	keys := make([]K, len(entries))
+	values := make([]V, len(entries))
+	for index, next := range entries {
+		keys[index] = next.Key()
+		values[index] = next.Value()
+	}
+	keySet := set.NewImmutableSetBuilder[K]().Add(keys...).Build()
+	if keySet.Size() != len(keys) {
+		panic("Duplicate key")
+	}
+	return &ImmutableMap[K, V]{keys: keySet, values: values}
+}
+
+func (v *ImmutableMap[K, V]) Size() int {
+	return len(v.values)
+}
+
+func (v *ImmutableMap[K, V]) IsEmpty() bool {
+	return len(v.values) == 0
+}
+
+func (v *ImmutableMap[K, V]) Contains(key K) bool {
+	return v.keys.Contains(key)
+}
+
+func (v *ImmutableMap[K, V]) Get(key K) (result V, ok bool) {
+	index := v.keys.IndexOf(key)
+	if index == -1 {
+		return
+	}
+	return v.values[index], true
+}
+
+func (v *ImmutableMap[K, V]) Put(key K, value V) {
+	immutable()
+}
+
+func (v *ImmutableMap[K, V]) PutAll(other Map[K, V]) {
+	immutable()
+}
+
+func (v *ImmutableMap[K, V]) Remove(key K) (oldValue V, removed bool) {
+	immutable()
+	return
+}
+
+func (v *ImmutableMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	return GetOrDefault[K, V](v, key, defaultValue)
+}
+
+func (v *ImmutableMap[K, V]) PutIfAbsent(key K, value V) (previous V, present bool) {
+	immutable()
+	return
+}
+
+func (v *ImmutableMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) (result V) {
+	immutable()
+	return
+}
+
+func (v *ImmutableMap[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	immutable()
+	return
+}
+
+func (v *ImmutableMap[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	immutable()
+	return
+}
+
+func (v *ImmutableMap[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	immutable()
+	return
+}
+
+func (v *ImmutableMap[K, V]) Replace(key K, value V) (oldValue V, replaced bool) {
+	immutable()
+	return
+}
+
+func (v *ImmutableMap[K, V]) ReplaceAll(function func(key K, value V) V) {
+	immutable()
+}
+
+func (v *ImmutableMap[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	return ForEach[K, V](v, visitor)
+}
+
+func (v *ImmutableMap[K, V]) RemoveAll(keys coll.Collection[K]) {
+	immutable()
+}
+
+func (v *ImmutableMap[K, V]) ContainsValue(what V) bool {
+	return v.ContainsValueFunc(what, coll.DefaultEqualizer[V]())
+}
+
+func (v *ImmutableMap[K, V]) ContainsValueFunc(what V, equals coll.Equalizer[V]) bool {
+	for _, next := range v.values {
+		if equals(next, what) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *ImmutableMap[K, V]) Keys() set.Set[K] {
+	return v.keys
+}
+
+func (v *ImmutableMap[K, V]) Values() coll.Collection[V] {
+	return list.ListOf(v.values...)
+}
+
+func (v *ImmutableMap[K, V]) KeySet() set.Set[K] {
+	return KeySet[K, V](v)
+}
+
+func (v *ImmutableMap[K, V]) ValuesView() coll.Collection[V] {
+	return ValuesView[K, V](v)
+}
+
+func (v *ImmutableMap[K, V]) EntrySet() coll.Collection[Entry[K, V]] {
+	return EntrySet[K, V](v)
+}
+
+func (v *ImmutableMap[K, V]) Iterator() coll.Iterator[KV[K, V]] {
+	return &ImmutableMapIterator[K, V]{Src: v}
+}
+
+func (v *ImmutableMap[K, V]) Stream() stream.Stream[KV[K, V]] {
+	return stream.FromIterator[KV[K, V]](v.Iterator())
+}
+
+type ImmutableMapIterator[K comparable, V any] struct {
+	Src          *ImmutableMap[K, V]
+	currentIndex int
+}
+
+func (v *ImmutableMapIterator[K, V]) Next() (result KV[K, V], ok bool) {
+	if v.currentIndex >= len(v.Src.values) {
+		return result, false
+	}
+	result = KVOf(v.Src.keys.Get(v.currentIndex), v.Src.values[v.currentIndex])
+	v.currentIndex++
+	return result, true
+}
+
+func (v *ImmutableMapIterator[K, V]) Remove() {
+	immutable()
+}
+
+func (v *ImmutableMapIterator[K, V]) Set(data KV[K, V]) KV[K, V] {
+	immutable()
+	return data
+}
+
+// Collects entries for an ImmutableMap. Putting an existing key replaces its value, but keeps its position
+type ImmutableMapBuilder[K comparable, V any] struct {
+	keys    []K
+	values  []V
+	indexes map[K]int
+}
+
+func NewImmutableMapBuilder[K comparable, V any]() *ImmutableMapBuilder[K, V] {
+	return &ImmutableMapBuilder[K, V]{indexes: make(map[K]int)}
+}
+
+func (v *ImmutableMapBuilder[K, V]) Put(key K, value V) *ImmutableMapBuilder[K, V] {
+	if index, ok := v.indexes[key]; ok {
+		v.values[index] = value
+		return v
+	}
+	v.indexes[key] = len(v.keys)
+	v.keys = append(v.keys, key)
+	v.values = append(v.values, value)
+	return v
+}
+
+func (v *ImmutableMapBuilder[K, V]) PutAll(other Map[K, V]) *ImmutableMapBuilder[K, V] {
+	coll.ForEach(other.Iterator(), func(i KV[K, V]) bool {
+		v.Put(i.Key(), i.Value())
+		return true
+	})
+	return v
+}
+
+// Returns an ImmutableMap with the entries put so far. The builder can still be used afterwards
+func (v *ImmutableMapBuilder[K, V]) Build() *ImmutableMap[K, V] {
+	values := make([]V, len(v.values))
+	copy(values, v.values)
+	return &ImmutableMap[K, V]{keys: set.SetOf(v.keys...), values: values}
+}
@@ -0,0 +1,63 @@
+package Map
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+func TestImmutableMap(t *testing.T) {
+	mp := MapOf3("a", 1, "b", 2, "c", 3)
+	common.AssertEq(t, mp.Size(), 3)
+	value, ok := mp.Get("b")
+	common.AssertTrue(t, ok)
+	common.AssertEq(t, value, 2)
+	_, ok = mp.Get("d")
+	common.AssertFalse(t, ok)
+	common.AssertTrue(t, mp.ContainsValue(3))
+	common.AssertArrEq(t, mp.Keys().ToArray(), []string{"a", "b", "c"})
+
+	// Safe to read from many goroutines
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				mp.Get("c")
+				mp.Keys().Contains("a")
+			}
+		}()
+	}
+	wg.Wait()
+
+	entries := MapOfEntries(KVOf(1, "x"), KVOf(2, "y"))
+	common.AssertEq(t, entries.Values().Size(), 2)
+
+	builder := NewImmutableMapBuilder[int, int]()
+	for i := 0; i < 20; i++ {
+		builder.Put(i%10, i)
+	}
+	built := builder.Build()
+	common.AssertEq(t, built.Size(), 10)
+	value, _ = built.Get(3)
+	common.AssertEq(t, value, 13)
+
+	// Untyped constants take the type of the map
+	floats := MapOf2("a", 1.5, "b", 2)
+	value2, _ := floats.Get("b")
+	common.AssertEq(t, value2, 2.0)
+	int64s := MapOf2[string, int64]("a", 1, "b", 2)
+	common.AssertEq(t, int64s.Size(), 2)
+	common.AssertEq(t, MapOf5(1, "a", 2, "b", 3, "c", 4, "d", 5, "e").Size(), 5)
+
+	for _, f := range []func(){
+		func() { MapOf2("a", 1, "a", 2) },
+		func() { MapOf4("a", 1, "b", 2, "c", 3, "a", 4) },
+		func() { mp.Put("d", 4) },
+		func() { mp.Keys().Add("d") },
+	} {
+		assertPanics(t, f)
+	}
+}
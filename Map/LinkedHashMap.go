@@ -0,0 +1,264 @@
+package Map
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+// Called after an entry is added by Put. Returns true if the eldest entry should be removed.
+// The map can be inspected, but must not be modified by this function.
+type RemoveEldestFunc[K comparable, V any] func(m Map[K, V], eldest KV[K, V]) bool
+
+type linkedHashMapEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *linkedHashMapEntry[K, V]
+	next  *linkedHashMapEntry[K, V]
+}
+
+// A hash map that iterates in insertion order, or in access order (least recently accessed first)
+type LinkedHashMap[K comparable, V any] struct {
+	data         map[K]*linkedHashMapEntry[K, V]
+	head         *linkedHashMapEntry[K, V]
+	tail         *linkedHashMapEntry[K, V]
+	accessOrder  bool
+	removeEldest RemoveEldestFunc[K, V]
+	generation   int
+}
+
+func (v *LinkedHashMap[K, V]) applyMod() {
+	v.generation++
+}
+
+func (v *LinkedHashMap[K, V]) modCount() int {
+	return v.generation
+}
+
+func (v *LinkedHashMap[K, V]) unlink(entry *linkedHashMapEntry[K, V]) {
+	if entry.prev == nil {
+		v.head = entry.next
+	} else {
+		entry.prev.next = entry.next
+	}
+	if entry.next == nil {
+		v.tail = entry.prev
+	} else {
+		entry.next.prev = entry.prev
+	}
+	entry.prev = nil
+	entry.next = nil
+}
+
+func (v *LinkedHashMap[K, V]) linkLast(entry *linkedHashMapEntry[K, V]) {
+	entry.prev = v.tail
+	entry.next = nil
+	if v.tail == nil {
+		v.head = entry
+	} else {
+		v.tail.next = entry
+	}
+	v.tail = entry
+}
+
+// In access order mode, move the entry to the tail
+func (v *LinkedHashMap[K, V]) afterAccess(entry *linkedHashMapEntry[K, V]) {
+	if v.accessOrder && v.tail != entry {
+		defer v.applyMod()
+		v.unlink(entry)
+		v.linkLast(entry)
+	}
+}
+
+func (v *LinkedHashMap[K, V]) removeEntry(entry *linkedHashMapEntry[K, V]) {
+	v.unlink(entry)
+	delete(v.data, entry.key)
+}
+
+func (v *LinkedHashMap[K, V]) Size() int {
+	return len(v.data)
+}
+
+func (v *LinkedHashMap[K, V]) IsEmpty() bool {
+	return len(v.data) == 0
+}
+
+func (v *LinkedHashMap[K, V]) Contains(key K) bool {
+	_, ok := v.data[key]
+	return ok
+}
+
+// In access order mode, Get moves the entry to the end of the iteration order
+func (v *LinkedHashMap[K, V]) Get(key K) (result V, ok bool) {
+	entry, ok := v.data[key]
+	if !ok {
+		return
+	}
+	v.afterAccess(entry)
+	return entry.value, true
+}
+
+// Putting an existing key keeps its position, unless in access order mode
+func (v *LinkedHashMap[K, V]) Put(key K, value V) {
+	defer v.applyMod()
+	if entry, ok := v.data[key]; ok {
+		entry.value = value
+		v.afterAccess(entry)
+		return
+	}
+	entry := &linkedHashMapEntry[K, V]{key: key, value: value}
+	v.data[key] = entry
+	v.linkLast(entry)
+	if v.removeEldest != nil && v.removeEldest(v, KVOf(v.head.key, v.head.value)) {
+		v.removeEntry(v.head)
+	}
+}
+
+func (v *LinkedHashMap[K, V]) PutAll(other Map[K, V]) {
+	coll.ForEach(
+		other.Iterator(),
+		func(i KV[K, V]) bool {
+			v.Put(i.Key(), i.Value())
+			return true
+		})
+}
+
+func (v *LinkedHashMap[K, V]) Remove(key K) (oldValue V, removed bool) {
+	entry, ok := v.data[key]
+	if !ok {
+		return
+	}
+	defer v.applyMod()
+	v.removeEntry(entry)
+	return entry.value, true
+}
+
+func (v *LinkedHashMap[K, V]) RemoveAll(keys coll.Collection[K]) {
+	coll.ForEach(keys.Iterator(), func(i K) bool {
+		v.Remove(i)
+		return true
+	})
+}
+
+func (v *LinkedHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	return GetOrDefault[K, V](v, key, defaultValue)
+}
+
+func (v *LinkedHashMap[K, V]) PutIfAbsent(key K, value V) (previous V, present bool) {
+	return PutIfAbsent[K, V](v, key, value)
+}
+
+func (v *LinkedHashMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return ComputeIfAbsent[K, V](v, key, mapping)
+}
+
+func (v *LinkedHashMap[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	return ComputeIfPresent[K, V](v, key, remapping)
+}
+
+func (v *LinkedHashMap[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	return Compute[K, V](v, key, remapping)
+}
+
+func (v *LinkedHashMap[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	return Merge[K, V](v, key, value, remapping)
+}
+
+func (v *LinkedHashMap[K, V]) Replace(key K, value V) (oldValue V, replaced bool) {
+	return Replace[K, V](v, key, value)
+}
+
+func (v *LinkedHashMap[K, V]) ReplaceAll(function func(key K, value V) V) {
+	ReplaceAll[K, V](v, function)
+}
+
+func (v *LinkedHashMap[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	return ForEach[K, V](v, visitor)
+}
+
+func (v *LinkedHashMap[K, V]) Clear() {
+	defer v.applyMod()
+	v.data = make(map[K]*linkedHashMapEntry[K, V])
+	v.head = nil
+	v.tail = nil
+}
+
+func (v *LinkedHashMap[K, V]) ContainsValue(what V) bool {
+	return v.ContainsValueFunc(what, coll.DefaultEqualizer[V]())
+}
+
+func (v *LinkedHashMap[K, V]) ContainsValueFunc(what V, equals coll.Equalizer[V]) bool {
+	for entry := v.head; entry != nil; entry = entry.next {
+		if equals(entry.value, what) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys are returned as a LinkedHashSet snapshot in iteration order
+func (v *LinkedHashMap[K, V]) Keys() set.Set[K] {
+	result := set.NewLinkedHashSet[K]()
+	for entry := v.head; entry != nil; entry = entry.next {
+		result.Add(entry.key)
+	}
+	return result
+}
+
+// Values are returned in iteration order
+func (v *LinkedHashMap[K, V]) Values() coll.Collection[V] {
+	result := list.NewArrayList[V]()
+	for entry := v.head; entry != nil; entry = entry.next {
+		result.Add(entry.value)
+	}
+	return result
+}
+
+func (v *LinkedHashMap[K, V]) KeySet() set.Set[K] {
+	return KeySet[K, V](v)
+}
+
+func (v *LinkedHashMap[K, V]) ValuesView() coll.Collection[V] {
+	return ValuesView[K, V](v)
+}
+
+func (v *LinkedHashMap[K, V]) EntrySet() coll.Collection[Entry[K, V]] {
+	return EntrySet[K, V](v)
+}
+
+func (v *LinkedHashMap[K, V]) Iterator() coll.Iterator[KV[K, V]] {
+	return NewLinkedHashMapIteratorFor(v)
+}
+
+func (v *LinkedHashMap[K, V]) Stream() stream.Stream[KV[K, V]] {
+	return stream.FromIterator[KV[K, V]](v.Iterator())
+}
+
+// Set the function that decides whether the eldest entry is removed after each Put that adds a new entry.
+// Together with access order mode, this turns the map into a LRU cache
+func (v *LinkedHashMap[K, V]) SetRemoveEldestEntry(f RemoveEldestFunc[K, V]) {
+	v.removeEldest = f
+}
+
+// Return new empty LinkedHashMap[K, V] that iterates in insertion order
+func NewLinkedHashMap[K comparable, V any]() *LinkedHashMap[K, V] {
+	return &LinkedHashMap[K, V]{data: make(map[K]*linkedHashMapEntry[K, V]), generation: 0}
+}
+
+// Return new empty LinkedHashMap[K, V] that iterates from least recently accessed to most recently accessed.
+// Get and Put both count as access
+func NewAccessOrderLinkedHashMap[K comparable, V any]() *LinkedHashMap[K, V] {
+	result := NewLinkedHashMap[K, V]()
+	result.accessOrder = true
+	return result
+}
+
+// Return new LinkedHashMap[K, V] in access order, that removes the least recently accessed entry when size exceeds capacity
+func NewLRUMap[K comparable, V any](capacity int) *LinkedHashMap[K, V] {
+	result := NewAccessOrderLinkedHashMap[K, V]()
+	result.SetRemoveEldestEntry(func(m Map[K, V], eldest KV[K, V]) bool {
+		return m.Size() > capacity
+	})
+	return result
+}
@@ -0,0 +1,60 @@
+package Map
+
+import coll "github.com/wushilin/gojava/Collection"
+
+type LinkedHashMapIterator[K comparable, V any] struct {
+	Src          *LinkedHashMap[K, V]
+	generation   int
+	next         *linkedHashMapEntry[K, V]
+	lastReturned *linkedHashMapEntry[K, V]
+}
+
+func (v *LinkedHashMapIterator[K, V]) applyMod() {
+	v.generation++
+	v.Src.generation = v.generation
+}
+
+func (v *LinkedHashMapIterator[K, V]) checkMod() {
+	if v.generation != v.Src.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *LinkedHashMapIterator[K, V]) Next() (result KV[K, V], ok bool) {
+	v.checkMod()
+	if v.next == nil {
+		return result, false
+	}
+	v.lastReturned = v.next
+	v.next = v.next.next
+	return KVOf(v.lastReturned.key, v.lastReturned.value), true
+}
+
+func (v *LinkedHashMapIterator[K, V]) Remove() {
+	v.checkMod()
+	if v.lastReturned == nil {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	defer v.applyMod()
+	v.Src.removeEntry(v.lastReturned)
+	v.lastReturned = nil
+}
+
+// Replaces the value in place. The entry keeps its position even in access order mode
+func (v *LinkedHashMapIterator[K, V]) Set(data KV[K, V]) KV[K, V] {
+	v.checkMod()
+	if v.lastReturned == nil {
+		panic("Don't call set before reading")
+	}
+	if v.lastReturned.key != data.Key() {
+		panic("Map iterator.Set must set the same key!")
+	}
+	defer v.applyMod()
+	old := KVOf(v.lastReturned.key, v.lastReturned.value)
+	v.lastReturned.value = data.Value()
+	return old
+}
+
+func NewLinkedHashMapIteratorFor[K comparable, V any](v *LinkedHashMap[K, V]) coll.Iterator[KV[K, V]] {
+	return &LinkedHashMapIterator[K, V]{Src: v, generation: v.generation, next: v.head}
+}
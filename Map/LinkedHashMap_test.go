@@ -0,0 +1,56 @@
+package Map
+
+import (
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+func TestLinkedHashMap(t *testing.T) {
+	mp := NewLinkedHashMap[int, string]()
+	order := []int{5, 3, 9, 1, 7, 2}
+	for _, i := range order {
+		mp.Put(i, "v")
+	}
+	PrintMap[int, string](mp)
+	common.AssertArrEq(t, keysOf[string](mp), order)
+	common.AssertArrEq(t, mp.Keys().ToArray(), order)
+
+	// Put on an existing key keeps the position
+	mp.Put(3, "updated")
+	common.AssertArrEq(t, keysOf[string](mp), order)
+	value, _ := mp.Get(3)
+	common.AssertEq(t, value, "updated")
+	common.AssertArrEq(t, mp.Values().ToArray(), []string{"v", "updated", "v", "v", "v", "v"})
+
+	iter := mp.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if next.Key() > 4 {
+			iter.Remove()
+		}
+	}
+	common.AssertArrEq(t, keysOf[string](mp), []int{3, 1, 2})
+	mp.Put(5, "again")
+	common.AssertArrEq(t, keysOf[string](mp), []int{3, 1, 2, 5})
+	common.AssertEq(t, mp.Stream().Count(), 4)
+}
+
+func TestLRUMap(t *testing.T) {
+	mp := NewLRUMap[string, int](3)
+	mp.Put("a", 1)
+	mp.Put("b", 2)
+	mp.Put("c", 3)
+	mp.Get("a")
+	mp.Put("d", 4)
+	common.AssertEq(t, mp.Size(), 3)
+	common.AssertFalse(t, mp.Contains("b"))
+	common.AssertArrEq(t, mp.Keys().ToArray(), []string{"c", "a", "d"})
+
+	mp.Put("c", 30)
+	common.AssertArrEq(t, mp.Keys().ToArray(), []string{"a", "d", "c"})
+
+	iter := mp.Iterator()
+	iter.Next()
+	mp.Get("a")
+	assertPanics(t, func() { iter.Next() })
+}
@@ -7,7 +7,7 @@ import (
 )
 
 // This interface represents a key value pair
-type KV[K comparable, V any] interface {
+type KV[K any, V any] interface {
 	// Return the key of the entry
 	Key() K
 
@@ -15,7 +15,7 @@ type KV[K comparable, V any] interface {
 	Value() V
 }
 
-type MapKV[K comparable, V any] struct {
+type MapKV[K any, V any] struct {
 	key   K
 	value V
 }
@@ -29,7 +29,7 @@ func (arg *MapKV[K, V]) Value() V {
 }
 
 // Create a KV from KV value pair
-func KVOf[K comparable, V any](key K, value V) KV[K, V] {
+func KVOf[K any, V any](key K, value V) KV[K, V] {
 	return &MapKV[K, V]{key: key, value: value}
 }
 
@@ -50,8 +50,38 @@ type Map[K comparable, V any] interface {
 	// Put all
 	PutAll(val Map[K, V])
 
-	// Remove by key
-	Remove(key K)
+	// Remove by key. Returns the removed value, and whether key was present
+	Remove(key K) (oldValue V, removed bool)
+
+	// Return the value for key, or defaultValue if key is absent
+	GetOrDefault(key K, defaultValue V) V
+
+	// If key is absent, put value. Returns the value that was present, and whether there was one
+	PutIfAbsent(key K, value V) (previous V, present bool)
+
+	// If key is absent, put the value returned by mapping. Returns the current value for key
+	ComputeIfAbsent(key K, mapping func(key K) V) V
+
+	// If key is present, replace its value with the one returned by remapping, or remove key when remapping
+	// returns keep == false. Returns the new value and whether key is present afterwards
+	ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool)
+
+	// Compute a new value for key from its current value (exists is false if key is absent), or remove key when
+	// remapping returns keep == false. Returns the new value and whether key is present afterwards
+	Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool)
+
+	// If key is absent, put value. Otherwise replace the current value with remapping(current, value), or remove key
+	// when remapping returns keep == false. Returns the new value and whether key is present afterwards
+	Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool)
+
+	// Replace the value only if key is present. Returns the old value and whether it was replaced
+	Replace(key K, value V) (oldValue V, replaced bool)
+
+	// Replace every value with the one returned by function
+	ReplaceAll(function func(key K, value V) V)
+
+	// Visit each entry. When visitor returns false, it stops. Returns the number of entries visited
+	ForEach(visitor func(key K, value V) bool) int
 
 	// Remove all keys
 	RemoveAll(keys coll.Collection[K])
@@ -66,14 +96,64 @@ type Map[K comparable, V any] interface {
 	// Calling Set(KV[K,V]) deletes the entry from map, but adds new kv to the map. Note that Set iterator's Set Function must set with same key.
 	Iterator() coll.Iterator[KV[K, V]]
 
-	// Return set of  Keys. It will not have duplicates. It is a snapshot, so calling remove for the set does nothing useful for you
+	// Return set of  Keys. It will not have duplicates. It is a snapshot, so calling remove for the set does nothing useful for you.
+	// See KeySet() for a live view
 	// Set has no order, and it does not guarantee the order is same as Values()
 	Keys() set.Set[K]
 
 	// Return collection of Values. Duplications might be there. It is a snapshot,  Calling Remove() or Set() does nothing useful for you.
+	// See ValuesView() for a live view.
 	// The values has no order.
 	Values() coll.Collection[V]
 
 	// Return stream of KV[K,V]. It uses iterator internally
 	Stream() stream.Stream[KV[K, V]]
+
+	// Return a live view of the keys. Removing from it, or through its iterator, removes the entries from the map.
+	// Adding to it panics
+	KeySet() set.Set[K]
+
+	// Return a live view of the values. Removing from it removes the entries from the map, and Set() of its
+	// iterator replaces the value. Adding to it panics
+	ValuesView() coll.Collection[V]
+
+	// Return a live view of the entries. Removing from it removes the entries from the map, and Entry.SetValue()
+	// replaces the value in the map. Adding to it panics
+	EntrySet() coll.Collection[Entry[K, V]]
+}
+
+// A Map that keeps its keys ordered by a comparator, and supports navigation by key.
+// Iterator(), Keys() and Values() follow the key order.
+type NavigableMap[K comparable, V any] interface {
+	Map[K, V]
+
+	// Return the lowest key, if map is empty, ok is set to false
+	FirstKey() (key K, ok bool)
+
+	// Return the highest key, if map is empty, ok is set to false
+	LastKey() (key K, ok bool)
+
+	// Return the greatest key less than or equal to the given key
+	FloorKey(key K) (result K, ok bool)
+
+	// Return the least key greater than or equal to the given key
+	CeilingKey(key K) (result K, ok bool)
+
+	// Return the greatest key strictly less than the given key
+	LowerKey(key K) (result K, ok bool)
+
+	// Return the least key strictly greater than the given key
+	HigherKey(key K) (result K, ok bool)
+
+	// Return a live view of the portion of this map whose keys are less than (or equal to, if inclusive) toKey
+	HeadMap(toKey K, inclusive bool) NavigableMap[K, V]
+
+	// Return a live view of the portion of this map whose keys are greater than (or equal to, if inclusive) fromKey
+	TailMap(fromKey K, inclusive bool) NavigableMap[K, V]
+
+	// Return a live view of the portion of this map whose keys range from fromKey to toKey
+	SubMap(fromKey K, fromInclusive bool, toKey K, toInclusive bool) NavigableMap[K, V]
+
+	// Return a live view of this map in reverse order
+	DescendingMap() NavigableMap[K, V]
 }
@@ -0,0 +1,389 @@
+package Map
+
+import (
+	"math/bits"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+// A slot of a trie node, holds either an entry or a child node
+type hamtSlot[K comparable, V any] struct {
+	hash  uint64
+	entry *MapKV[K, V]
+	child *hamtNode[K, V]
+}
+
+// A node of the hash array mapped trie. Each level consumes 5 bits of the hash, bitmap tells which of
+// the 32 positions are used and slots only holds the used ones, in order.
+// Keys whose hashes are fully equal end up in a collision node, which is a plain list of entries.
+// Nodes are never modified once they are reachable from a map
+type hamtNode[K comparable, V any] struct {
+	bitmap    uint32
+	collision bool
+	slots     []hamtSlot[K, V]
+}
+
+// An immutable Map stored in a hash array mapped trie. With() and Without() return a new map
+// and leave this one untouched, the two share all nodes except the path to the changed key,
+// so each update costs O(log32 n) instead of a full copy.
+// It implements Map, methods of Map that modify it panic. It is safe to share across goroutines.
+// Iteration order is not defined, but is the same for maps with the same keys
+type PersistentMap[K comparable, V any] struct {
+	root *hamtNode[K, V]
+	size int
+}
+
+// Return an empty PersistentMap
+func NewPersistentMap[K comparable, V any]() *PersistentMap[K, V] {
+	return &PersistentMap[K, V]{root: &hamtNode[K, V]{}}
+}
+
+// Return a PersistentMap with the entries of other
+func PersistentMapFrom[K comparable, V any](other Map[K, V]) *PersistentMap[K, V] {
+	result := NewPersistentMap[K, V]()
+	coll.ForEach(other.Iterator(), func(i KV[K, V]) bool {
+		result = result.With(i.Key(), i.Value())
+		return true
+	})
+	return result
+}
+
+// Position of hash in a node at shift
+func hamtBit(hash uint64, shift int) uint32 {
+	return 1 << ((hash >> shift) & hamtMask)
+}
+
+// Index in slots for bit
+func (v *hamtNode[K, V]) slotIndex(bit uint32) int {
+	return bits.OnesCount32(v.bitmap & (bit - 1))
+}
+
+func (v *hamtNode[K, V]) find(shift int, hash uint64, key K) *MapKV[K, V] {
+	node := v
+	for {
+		if node.collision {
+			for _, next := range node.slots {
+				if next.entry.key == key {
+					return next.entry
+				}
+			}
+			return nil
+		}
+		bit := hamtBit(hash, shift)
+		if node.bitmap&bit == 0 {
+			return nil
+		}
+		slot := node.slots[node.slotIndex(bit)]
+		if slot.child == nil {
+			if slot.hash == hash && slot.entry.key == key {
+				return slot.entry
+			}
+			return nil
+		}
+		node = slot.child
+		shift += hamtBits
+	}
+}
+
+// Returns a node with the slot set, and whether it is a new key
+func (v *hamtNode[K, V]) with(shift int, slot hamtSlot[K, V]) (*hamtNode[K, V], bool) {
+	if v.collision {
+		for index, next := range v.slots {
+			if next.entry.key == slot.entry.key {
+				return v.replaceSlot(index, slot), false
+			}
+		}
+		slots := make([]hamtSlot[K, V], len(v.slots), len(v.slots)+1)
+		copy(slots, v.slots)
+		return &hamtNode[K, V]{collision: true, slots: append(slots, slot)}, true
+	}
+	bit := hamtBit(slot.hash, shift)
+	index := v.slotIndex(bit)
+	if v.bitmap&bit == 0 {
+		slots := make([]hamtSlot[K, V], len(v.slots)+1)
+		copy(slots, v.slots[:index])
+		slots[index] = slot
+		copy(slots[index+1:], v.slots[index:])
+		return &hamtNode[K, V]{bitmap: v.bitmap | bit, slots: slots}, true
+	}
+	existing := v.slots[index]
+	if existing.child != nil {
+		child, added := existing.child.with(shift+hamtBits, slot)
+		return v.replaceSlot(index, hamtSlot[K, V]{child: child}), added
+	}
+	if existing.hash == slot.hash && existing.entry.key == slot.entry.key {
+		return v.replaceSlot(index, slot), false
+	}
+	child := mergeSlots(shift+hamtBits, existing, slot)
+	return v.replaceSlot(index, hamtSlot[K, V]{child: child}), true
+}
+
+// A node holding two entries that share the same position at the previous level
+func mergeSlots[K comparable, V any](shift int, first, second hamtSlot[K, V]) *hamtNode[K, V] {
+	if shift >= 64 {
+		return &hamtNode[K, V]{collision: true, slots: []hamtSlot[K, V]{first, second}}
+	}
+	firstBit := hamtBit(first.hash, shift)
+	secondBit := hamtBit(second.hash, shift)
+	if firstBit == secondBit {
+		child := mergeSlots(shift+hamtBits, first, second)
+		return &hamtNode[K, V]{bitmap: firstBit, slots: []hamtSlot[K, V]{{child: child}}}
+	}
+	if firstBit > secondBit {
+		first, second = second, first
+	}
+	return &hamtNode[K, V]{bitmap: firstBit | secondBit, slots: []hamtSlot[K, V]{first, second}}
+}
+
+// Returns a node without key, nil if the node becomes empty. removed tells whether key was found
+func (v *hamtNode[K, V]) without(shift int, hash uint64, key K) (result *hamtNode[K, V], removed bool) {
+	if v.collision {
+		for index, next := range v.slots {
+			if next.entry.key == key {
+				return v.removeSlot(index, 0), true
+			}
+		}
+		return v, false
+	}
+	bit := hamtBit(hash, shift)
+	if v.bitmap&bit == 0 {
+		return v, false
+	}
+	index := v.slotIndex(bit)
+	existing := v.slots[index]
+	if existing.child == nil {
+		if existing.hash != hash || existing.entry.key != key {
+			return v, false
+		}
+		return v.removeSlot(index, bit), true
+	}
+	child, removed := existing.child.without(shift+hamtBits, hash, key)
+	if !removed {
+		return v, false
+	}
+	if child == nil {
+		return v.removeSlot(index, bit), true
+	}
+	if len(child.slots) == 1 && child.slots[0].child == nil {
+		// A single entry doesn't need a node of its own
+		return v.replaceSlot(index, child.slots[0]), true
+	}
+	return v.replaceSlot(index, hamtSlot[K, V]{child: child}), true
+}
+
+func (v *hamtNode[K, V]) replaceSlot(index int, slot hamtSlot[K, V]) *hamtNode[K, V] {
+	slots := make([]hamtSlot[K, V], len(v.slots))
+	copy(slots, v.slots)
+	slots[index] = slot
+	return &hamtNode[K, V]{bitmap: v.bitmap, collision: v.collision, slots: slots}
+}
+
+func (v *hamtNode[K, V]) removeSlot(index int, bit uint32) *hamtNode[K, V] {
+	if len(v.slots) == 1 {
+		return nil
+	}
+	slots := make([]hamtSlot[K, V], 0, len(v.slots)-1)
+	slots = append(slots, v.slots[:index]...)
+	slots = append(slots, v.slots[index+1:]...)
+	return &hamtNode[K, V]{bitmap: v.bitmap &^ bit, collision: v.collision, slots: slots}
+}
+
+// Returns a new map with key set to value
+func (v *PersistentMap[K, V]) With(key K, value V) *PersistentMap[K, V] {
+	slot := hamtSlot[K, V]{hash: hashOf(key), entry: &MapKV[K, V]{key: key, value: value}}
+	root, added := v.root.with(0, slot)
+	size := v.size
+	if added {
+		size++
+	}
+	return &PersistentMap[K, V]{root: root, size: size}
+}
+
+// Returns a new map without key. Returns this map if key is not in it
+func (v *PersistentMap[K, V]) Without(key K) *PersistentMap[K, V] {
+	root, removed := v.root.without(0, hashOf(key), key)
+	if !removed {
+		return v
+	}
+	if root == nil {
+		root = &hamtNode[K, V]{}
+	}
+	return &PersistentMap[K, V]{root: root, size: v.size - 1}
+}
+
+func (v *PersistentMap[K, V]) Size() int {
+	return v.size
+}
+
+func (v *PersistentMap[K, V]) IsEmpty() bool {
+	return v.size == 0
+}
+
+func (v *PersistentMap[K, V]) Contains(key K) bool {
+	return v.root.find(0, hashOf(key), key) != nil
+}
+
+func (v *PersistentMap[K, V]) Get(key K) (result V, ok bool) {
+	entry := v.root.find(0, hashOf(key), key)
+	if entry == nil {
+		return
+	}
+	return entry.value, true
+}
+
+func (v *PersistentMap[K, V]) Put(key K, value V) {
+	immutable()
+}
+
+func (v *PersistentMap[K, V]) PutAll(other Map[K, V]) {
+	immutable()
+}
+
+func (v *PersistentMap[K, V]) Remove(key K) (oldValue V, removed bool) {
+	immutable()
+	return
+}
+
+func (v *PersistentMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	return GetOrDefault[K, V](v, key, defaultValue)
+}
+
+func (v *PersistentMap[K, V]) PutIfAbsent(key K, value V) (previous V, present bool) {
+	immutable()
+	return
+}
+
+func (v *PersistentMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) (result V) {
+	immutable()
+	return
+}
+
+func (v *PersistentMap[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	immutable()
+	return
+}
+
+func (v *PersistentMap[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	immutable()
+	return
+}
+
+func (v *PersistentMap[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	immutable()
+	return
+}
+
+func (v *PersistentMap[K, V]) Replace(key K, value V) (oldValue V, replaced bool) {
+	immutable()
+	return
+}
+
+func (v *PersistentMap[K, V]) ReplaceAll(function func(key K, value V) V) {
+	immutable()
+}
+
+func (v *PersistentMap[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	return ForEach[K, V](v, visitor)
+}
+
+func (v *PersistentMap[K, V]) RemoveAll(keys coll.Collection[K]) {
+	immutable()
+}
+
+func (v *PersistentMap[K, V]) ContainsValue(what V) bool {
+	return v.ContainsValueFunc(what, coll.DefaultEqualizer[V]())
+}
+
+func (v *PersistentMap[K, V]) ContainsValueFunc(what V, equals coll.Equalizer[V]) bool {
+	found := false
+	coll.ForEach(v.Iterator(), func(i KV[K, V]) bool {
+		found = equals(i.Value(), what)
+		return !found
+	})
+	return found
+}
+
+// Returns an ImmutableSet of the keys
+func (v *PersistentMap[K, V]) Keys() set.Set[K] {
+	builder := set.NewImmutableSetBuilder[K]()
+	coll.ForEach(v.Iterator(), func(i KV[K, V]) bool {
+		builder.Add(i.Key())
+		return true
+	})
+	return builder.Build()
+}
+
+// Returns an ImmutableList of the values, in iteration order
+func (v *PersistentMap[K, V]) Values() coll.Collection[V] {
+	builder := list.NewImmutableListBuilder[V]()
+	coll.ForEach(v.Iterator(), func(i KV[K, V]) bool {
+		builder.Add(i.Value())
+		return true
+	})
+	return builder.Build()
+}
+
+func (v *PersistentMap[K, V]) KeySet() set.Set[K] {
+	return KeySet[K, V](v)
+}
+
+func (v *PersistentMap[K, V]) ValuesView() coll.Collection[V] {
+	return ValuesView[K, V](v)
+}
+
+func (v *PersistentMap[K, V]) EntrySet() coll.Collection[Entry[K, V]] {
+	return EntrySet[K, V](v)
+}
+
+func (v *PersistentMap[K, V]) Iterator() coll.Iterator[KV[K, V]] {
+	return &PersistentMapIterator[K, V]{stack: []hamtCursor[K, V]{{node: v.root}}}
+}
+
+func (v *PersistentMap[K, V]) Stream() stream.Stream[KV[K, V]] {
+	return stream.FromIterator[KV[K, V]](v.Iterator())
+}
+
+type hamtCursor[K comparable, V any] struct {
+	node  *hamtNode[K, V]
+	index int
+}
+
+// Walks the trie depth first, keeping the path from the root in a stack
+type PersistentMapIterator[K comparable, V any] struct {
+	stack []hamtCursor[K, V]
+}
+
+func (v *PersistentMapIterator[K, V]) Next() (result KV[K, V], ok bool) {
+	for len(v.stack) > 0 {
+		top := &v.stack[len(v.stack)-1]
+		if top.index >= len(top.node.slots) {
+			v.stack = v.stack[:len(v.stack)-1]
+			continue
+		}
+		slot := top.node.slots[top.index]
+		top.index++
+		if slot.child != nil {
+			v.stack = append(v.stack, hamtCursor[K, V]{node: slot.child})
+			continue
+		}
+		return slot.entry, true
+	}
+	return result, false
+}
+
+func (v *PersistentMapIterator[K, V]) Remove() {
+	immutable()
+}
+
+func (v *PersistentMapIterator[K, V]) Set(data KV[K, V]) KV[K, V] {
+	immutable()
+	return data
+}
@@ -0,0 +1,93 @@
+package Map
+
+import (
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+func TestPersistentMap(t *testing.T) {
+	n := 3000
+	versions := []*PersistentMap[int, int]{NewPersistentMap[int, int]()}
+	for i := 0; i < n; i++ {
+		versions = append(versions, versions[i].With(i, i*2))
+	}
+	for size, version := range versions {
+		common.AssertEq(t, version.Size(), size)
+		common.AssertFalse(t, version.Contains(size))
+		if size > 0 {
+			value, ok := version.Get(size - 1)
+			common.AssertTrue(t, ok)
+			common.AssertEq(t, value, (size-1)*2)
+		}
+	}
+	full := versions[n]
+	common.AssertEq(t, full.Keys().Size(), n)
+	common.AssertEq(t, full.Stream().Count(), n)
+	common.AssertTrue(t, full.ContainsValue(20))
+	common.AssertFalse(t, full.ContainsValue(21))
+
+	replaced := full.With(10, -1)
+	common.AssertEq(t, replaced.Size(), n)
+	value, _ := replaced.Get(10)
+	common.AssertEq(t, value, -1)
+	value, _ = full.Get(10)
+	common.AssertEq(t, value, 20)
+
+	removed := full
+	for i := 0; i < n; i += 2 {
+		removed = removed.Without(i)
+	}
+	common.AssertEq(t, removed.Size(), n/2)
+	common.AssertTrue(t, removed.Without(0) == removed)
+	for i := 0; i < n; i++ {
+		common.AssertEq(t, removed.Contains(i), i%2 == 1)
+		common.AssertTrue(t, full.Contains(i))
+	}
+	for i := 1; i < n; i += 2 {
+		removed = removed.Without(i)
+	}
+	common.AssertTrue(t, removed.IsEmpty())
+	_, ok := removed.Iterator().Next()
+	common.AssertFalse(t, ok)
+}
+
+func TestPersistentMapAsMap(t *testing.T) {
+	source := NewHashMap[string, int]()
+	source.Put("a", 1)
+	source.Put("b", 2)
+	var m Map[string, int] = PersistentMapFrom[string, int](source)
+	common.AssertEq(t, m.Size(), 2)
+	common.AssertTrue(t, m.Keys().Contains("b"))
+	common.AssertEq(t, m.Values().Size(), 2)
+	assertPanics(t, func() { m.Put("c", 3) })
+}
+
+func TestPersistentMapCollision(t *testing.T) {
+	// Force full hash collisions, which real keys can't reliably produce
+	slot := func(key string, value int) hamtSlot[string, int] {
+		return hamtSlot[string, int]{hash: 42, entry: &MapKV[string, int]{key: key, value: value}}
+	}
+	root := &hamtNode[string, int]{}
+	root, _ = root.with(0, slot("a", 1))
+	root, _ = root.with(0, slot("b", 2))
+	root, added := root.with(0, slot("c", 3))
+	common.AssertTrue(t, added)
+	root, added = root.with(0, slot("b", 4))
+	common.AssertFalse(t, added)
+	common.AssertEq(t, root.find(0, 42, "b").value, 4)
+	common.AssertEq(t, root.find(0, 42, "c").value, 3)
+	common.AssertTrue(t, root.find(0, 42, "d") == nil)
+
+	m := &PersistentMap[string, int]{root: root, size: 3}
+	common.AssertEq(t, m.Stream().Count(), 3)
+
+	root, _ = root.without(0, 42, "a")
+	root, _ = root.without(0, 42, "c")
+	// The last entry moves back up to the root
+	common.AssertEq(t, len(root.slots), 1)
+	common.AssertTrue(t, root.slots[0].child == nil)
+	common.AssertEq(t, root.find(0, 42, "b").value, 4)
+	root, _ = root.without(0, 42, "b")
+	common.AssertTrue(t, root == nil)
+}
@@ -0,0 +1,238 @@
+package Map
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/gojava/internal/rbtree"
+	"github.com/wushilin/stream"
+)
+
+// A red-black tree based map. Keys are ordered by the comparator given at creation time.
+type TreeMap[K comparable, V any] struct {
+	data       *rbtree.Tree[K, V]
+	generation int
+}
+
+func (v *TreeMap[K, V]) applyMod() {
+	v.generation++
+}
+
+func (v *TreeMap[K, V]) modCount() int {
+	return v.generation
+}
+
+func (v *TreeMap[K, V]) Size() int {
+	return v.data.Size()
+}
+
+func (v *TreeMap[K, V]) IsEmpty() bool {
+	return v.data.Size() == 0
+}
+
+func (v *TreeMap[K, V]) Contains(key K) bool {
+	return v.data.Get(key) != nil
+}
+
+func (v *TreeMap[K, V]) Get(key K) (result V, ok bool) {
+	node := v.data.Get(key)
+	if node == nil {
+		return
+	}
+	return node.Value, true
+}
+
+func (v *TreeMap[K, V]) Put(key K, value V) {
+	defer v.applyMod()
+	v.data.Put(key, value)
+}
+
+func (v *TreeMap[K, V]) PutAll(other Map[K, V]) {
+	coll.ForEach(
+		other.Iterator(),
+		func(i KV[K, V]) bool {
+			v.Put(i.Key(), i.Value())
+			return true
+		})
+}
+
+func (v *TreeMap[K, V]) Remove(key K) (oldValue V, removed bool) {
+	node := v.data.Get(key)
+	if node == nil {
+		return
+	}
+	defer v.applyMod()
+	oldValue = node.Value
+	v.data.Delete(node)
+	return oldValue, true
+}
+
+func (v *TreeMap[K, V]) RemoveAll(keys coll.Collection[K]) {
+	coll.ForEach(keys.Iterator(), func(i K) bool {
+		v.Remove(i)
+		return true
+	})
+}
+
+func (v *TreeMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	return GetOrDefault[K, V](v, key, defaultValue)
+}
+
+func (v *TreeMap[K, V]) PutIfAbsent(key K, value V) (previous V, present bool) {
+	return PutIfAbsent[K, V](v, key, value)
+}
+
+func (v *TreeMap[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return ComputeIfAbsent[K, V](v, key, mapping)
+}
+
+func (v *TreeMap[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	return ComputeIfPresent[K, V](v, key, remapping)
+}
+
+func (v *TreeMap[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	return Compute[K, V](v, key, remapping)
+}
+
+func (v *TreeMap[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	return Merge[K, V](v, key, value, remapping)
+}
+
+func (v *TreeMap[K, V]) Replace(key K, value V) (oldValue V, replaced bool) {
+	return Replace[K, V](v, key, value)
+}
+
+func (v *TreeMap[K, V]) ReplaceAll(function func(key K, value V) V) {
+	ReplaceAll[K, V](v, function)
+}
+
+func (v *TreeMap[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	return ForEach[K, V](v, visitor)
+}
+
+func (v *TreeMap[K, V]) Clear() {
+	defer v.applyMod()
+	v.data.Clear()
+}
+
+func (v *TreeMap[K, V]) ContainsValue(what V) bool {
+	return v.ContainsValueFunc(what, coll.DefaultEqualizer[V]())
+}
+
+func (v *TreeMap[K, V]) ContainsValueFunc(what V, equals coll.Equalizer[V]) bool {
+	return containsValueIn(v.data.All(), what, equals)
+}
+
+// Keys are returned as a TreeSet snapshot in ascending order
+func (v *TreeMap[K, V]) Keys() set.Set[K] {
+	return keysIn(v.data.All())
+}
+
+// Values are returned in ascending order of their keys
+func (v *TreeMap[K, V]) Values() coll.Collection[V] {
+	return valuesIn(v.data.All())
+}
+
+func (v *TreeMap[K, V]) KeySet() set.Set[K] {
+	return KeySet[K, V](v)
+}
+
+func (v *TreeMap[K, V]) ValuesView() coll.Collection[V] {
+	return ValuesView[K, V](v)
+}
+
+func (v *TreeMap[K, V]) EntrySet() coll.Collection[Entry[K, V]] {
+	return EntrySet[K, V](v)
+}
+
+func (v *TreeMap[K, V]) Iterator() coll.Iterator[KV[K, V]] {
+	return newTreeMapIterator(v, v.data.All())
+}
+
+func (v *TreeMap[K, V]) Stream() stream.Stream[KV[K, V]] {
+	return stream.FromIterator[KV[K, V]](v.Iterator())
+}
+
+func (v *TreeMap[K, V]) FirstKey() (K, bool) {
+	return keyOf(v.data.First())
+}
+
+func (v *TreeMap[K, V]) LastKey() (K, bool) {
+	return keyOf(v.data.Last())
+}
+
+func (v *TreeMap[K, V]) FloorKey(key K) (K, bool) {
+	return keyOf(v.data.Floor(key))
+}
+
+func (v *TreeMap[K, V]) CeilingKey(key K) (K, bool) {
+	return keyOf(v.data.Ceiling(key))
+}
+
+func (v *TreeMap[K, V]) LowerKey(key K) (K, bool) {
+	return keyOf(v.data.Lower(key))
+}
+
+func (v *TreeMap[K, V]) HigherKey(key K) (K, bool) {
+	return keyOf(v.data.Higher(key))
+}
+
+func (v *TreeMap[K, V]) HeadMap(toKey K, inclusive bool) NavigableMap[K, V] {
+	return &treeMapView[K, V]{src: v, bounds: v.data.All().Head(toKey, inclusive)}
+}
+
+func (v *TreeMap[K, V]) TailMap(fromKey K, inclusive bool) NavigableMap[K, V] {
+	return &treeMapView[K, V]{src: v, bounds: v.data.All().Tail(fromKey, inclusive)}
+}
+
+func (v *TreeMap[K, V]) SubMap(fromKey K, fromInclusive bool, toKey K, toInclusive bool) NavigableMap[K, V] {
+	return &treeMapView[K, V]{src: v, bounds: v.data.All().Sub(fromKey, fromInclusive, toKey, toInclusive)}
+}
+
+func (v *TreeMap[K, V]) DescendingMap() NavigableMap[K, V] {
+	return &treeMapView[K, V]{src: v, bounds: v.data.All().Reversed()}
+}
+
+func keyOf[K comparable, V any](node *rbtree.Node[K, V]) (result K, ok bool) {
+	if node == nil {
+		return
+	}
+	return node.Key, true
+}
+
+func containsValueIn[K comparable, V any](bounds rbtree.Range[K, V], what V, equals coll.Equalizer[V]) bool {
+	for node := bounds.First(); node != nil; node = bounds.Next(node) {
+		if equals(node.Value, what) {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys are collected into a TreeSet that iterates in the same order as bounds
+func keysIn[K comparable, V any](bounds rbtree.Range[K, V]) set.Set[K] {
+	comparator := bounds.Tree.Comparator
+	if bounds.Descending {
+		comparator = func(v1, v2 K) int {
+			return bounds.Tree.Comparator(v2, v1)
+		}
+	}
+	result := set.NewTreeSet[K](comparator)
+	for node := bounds.First(); node != nil; node = bounds.Next(node) {
+		result.Add(node.Key)
+	}
+	return result
+}
+
+func valuesIn[K comparable, V any](bounds rbtree.Range[K, V]) coll.Collection[V] {
+	result := list.NewArrayList[V]()
+	for node := bounds.First(); node != nil; node = bounds.Next(node) {
+		result.Add(node.Value)
+	}
+	return result
+}
+
+// Return new empty TreeMap[K, V], keys are ordered by comparator
+func NewTreeMap[K comparable, V any](comparator coll.Comparator[K]) *TreeMap[K, V] {
+	return &TreeMap[K, V]{data: rbtree.New[K, V](comparator), generation: 0}
+}
@@ -0,0 +1,67 @@
+package Map
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/internal/rbtree"
+)
+
+type TreeMapIterator[K comparable, V any] struct {
+	Src          *TreeMap[K, V]
+	generation   int
+	bounds       rbtree.Range[K, V]
+	next         *rbtree.Node[K, V]
+	lastReturned *rbtree.Node[K, V]
+}
+
+func (v *TreeMapIterator[K, V]) applyMod() {
+	v.generation++
+	v.Src.generation = v.generation
+}
+
+func (v *TreeMapIterator[K, V]) checkMod() {
+	if v.generation != v.Src.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *TreeMapIterator[K, V]) Next() (result KV[K, V], ok bool) {
+	v.checkMod()
+	if v.next == nil {
+		return result, false
+	}
+	v.lastReturned = v.next
+	v.next = v.bounds.Next(v.next)
+	return KVOf(v.lastReturned.Key, v.lastReturned.Value), true
+}
+
+func (v *TreeMapIterator[K, V]) Remove() {
+	v.checkMod()
+	if v.lastReturned == nil {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	defer v.applyMod()
+	// Deleting a node with two children moves its successor into it
+	if !v.bounds.Descending && v.next != nil && v.lastReturned.HasTwoChildren() {
+		v.next = v.lastReturned
+	}
+	v.Src.data.Delete(v.lastReturned)
+	v.lastReturned = nil
+}
+
+func (v *TreeMapIterator[K, V]) Set(data KV[K, V]) KV[K, V] {
+	v.checkMod()
+	if v.lastReturned == nil {
+		panic("Don't call set before reading")
+	}
+	if v.Src.data.Comparator(v.lastReturned.Key, data.Key()) != 0 {
+		panic("Map iterator.Set must set the same key!")
+	}
+	defer v.applyMod()
+	old := KVOf(v.lastReturned.Key, v.lastReturned.Value)
+	v.lastReturned.Value = data.Value()
+	return old
+}
+
+func newTreeMapIterator[K comparable, V any](src *TreeMap[K, V], bounds rbtree.Range[K, V]) coll.Iterator[KV[K, V]] {
+	return &TreeMapIterator[K, V]{Src: src, generation: src.generation, bounds: bounds, next: bounds.First()}
+}
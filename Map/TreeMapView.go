@@ -0,0 +1,181 @@
+package Map
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/gojava/internal/rbtree"
+	"github.com/wushilin/stream"
+)
+
+// A live view over a range of a TreeMap, optionally in descending order
+type treeMapView[K comparable, V any] struct {
+	src    *TreeMap[K, V]
+	bounds rbtree.Range[K, V]
+}
+
+func (v *treeMapView[K, V]) Size() int {
+	return v.bounds.Size()
+}
+
+func (v *treeMapView[K, V]) IsEmpty() bool {
+	return v.bounds.First() == nil
+}
+
+func (v *treeMapView[K, V]) Contains(key K) bool {
+	return v.bounds.InRange(key) && v.src.Contains(key)
+}
+
+func (v *treeMapView[K, V]) Get(key K) (result V, ok bool) {
+	if !v.bounds.InRange(key) {
+		return
+	}
+	return v.src.Get(key)
+}
+
+func (v *treeMapView[K, V]) Put(key K, value V) {
+	v.bounds.RangeCheck(key)
+	v.src.Put(key, value)
+}
+
+func (v *treeMapView[K, V]) PutAll(other Map[K, V]) {
+	coll.ForEach(
+		other.Iterator(),
+		func(i KV[K, V]) bool {
+			v.Put(i.Key(), i.Value())
+			return true
+		})
+}
+
+func (v *treeMapView[K, V]) Remove(key K) (oldValue V, removed bool) {
+	if v.bounds.InRange(key) {
+		return v.src.Remove(key)
+	}
+	return
+}
+
+func (v *treeMapView[K, V]) RemoveAll(keys coll.Collection[K]) {
+	coll.ForEach(keys.Iterator(), func(i K) bool {
+		v.Remove(i)
+		return true
+	})
+}
+
+func (v *treeMapView[K, V]) GetOrDefault(key K, defaultValue V) V {
+	return GetOrDefault[K, V](v, key, defaultValue)
+}
+
+func (v *treeMapView[K, V]) PutIfAbsent(key K, value V) (previous V, present bool) {
+	return PutIfAbsent[K, V](v, key, value)
+}
+
+func (v *treeMapView[K, V]) ComputeIfAbsent(key K, mapping func(key K) V) V {
+	return ComputeIfAbsent[K, V](v, key, mapping)
+}
+
+func (v *treeMapView[K, V]) ComputeIfPresent(key K, remapping func(key K, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	return ComputeIfPresent[K, V](v, key, remapping)
+}
+
+func (v *treeMapView[K, V]) Compute(key K, remapping func(key K, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	return Compute[K, V](v, key, remapping)
+}
+
+func (v *treeMapView[K, V]) Merge(key K, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	return Merge[K, V](v, key, value, remapping)
+}
+
+func (v *treeMapView[K, V]) Replace(key K, value V) (oldValue V, replaced bool) {
+	return Replace[K, V](v, key, value)
+}
+
+func (v *treeMapView[K, V]) ReplaceAll(function func(key K, value V) V) {
+	ReplaceAll[K, V](v, function)
+}
+
+func (v *treeMapView[K, V]) ForEach(visitor func(key K, value V) bool) int {
+	return ForEach[K, V](v, visitor)
+}
+
+// Remove all entries in range from the backing map
+func (v *treeMapView[K, V]) Clear() {
+	iter := v.Iterator()
+	for _, ok := iter.Next(); ok; _, ok = iter.Next() {
+		iter.Remove()
+	}
+}
+
+func (v *treeMapView[K, V]) ContainsValue(what V) bool {
+	return v.ContainsValueFunc(what, coll.DefaultEqualizer[V]())
+}
+
+func (v *treeMapView[K, V]) ContainsValueFunc(what V, equals coll.Equalizer[V]) bool {
+	return containsValueIn(v.bounds, what, equals)
+}
+
+func (v *treeMapView[K, V]) Keys() set.Set[K] {
+	return keysIn(v.bounds)
+}
+
+func (v *treeMapView[K, V]) Values() coll.Collection[V] {
+	return valuesIn(v.bounds)
+}
+
+func (v *treeMapView[K, V]) KeySet() set.Set[K] {
+	return KeySet[K, V](v)
+}
+
+func (v *treeMapView[K, V]) ValuesView() coll.Collection[V] {
+	return ValuesView[K, V](v)
+}
+
+func (v *treeMapView[K, V]) EntrySet() coll.Collection[Entry[K, V]] {
+	return EntrySet[K, V](v)
+}
+
+func (v *treeMapView[K, V]) Iterator() coll.Iterator[KV[K, V]] {
+	return newTreeMapIterator(v.src, v.bounds)
+}
+
+func (v *treeMapView[K, V]) Stream() stream.Stream[KV[K, V]] {
+	return stream.FromIterator[KV[K, V]](v.Iterator())
+}
+
+func (v *treeMapView[K, V]) FirstKey() (K, bool) {
+	return keyOf(v.bounds.First())
+}
+
+func (v *treeMapView[K, V]) LastKey() (K, bool) {
+	return keyOf(v.bounds.Last())
+}
+
+func (v *treeMapView[K, V]) FloorKey(key K) (K, bool) {
+	return keyOf(v.bounds.Floor(key))
+}
+
+func (v *treeMapView[K, V]) CeilingKey(key K) (K, bool) {
+	return keyOf(v.bounds.Ceiling(key))
+}
+
+func (v *treeMapView[K, V]) LowerKey(key K) (K, bool) {
+	return keyOf(v.bounds.Lower(key))
+}
+
+func (v *treeMapView[K, V]) HigherKey(key K) (K, bool) {
+	return keyOf(v.bounds.Higher(key))
+}
+
+func (v *treeMapView[K, V]) HeadMap(toKey K, inclusive bool) NavigableMap[K, V] {
+	return &treeMapView[K, V]{src: v.src, bounds: v.bounds.Head(toKey, inclusive)}
+}
+
+func (v *treeMapView[K, V]) TailMap(fromKey K, inclusive bool) NavigableMap[K, V] {
+	return &treeMapView[K, V]{src: v.src, bounds: v.bounds.Tail(fromKey, inclusive)}
+}
+
+func (v *treeMapView[K, V]) SubMap(fromKey K, fromInclusive bool, toKey K, toInclusive bool) NavigableMap[K, V] {
+	return &treeMapView[K, V]{src: v.src, bounds: v.bounds.Sub(fromKey, fromInclusive, toKey, toInclusive)}
+}
+
+func (v *treeMapView[K, V]) DescendingMap() NavigableMap[K, V] {
+	return &treeMapView[K, V]{src: v.src, bounds: v.bounds.Reversed()}
+}
@@ -0,0 +1,125 @@
+package Map
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/common"
+)
+
+func intCompare(a, b int) int {
+	return a - b
+}
+
+func keysOf[V any](mp Map[int, V]) []int {
+	result := []int{}
+	coll.ForEach(mp.Iterator(), func(i KV[int, V]) bool {
+		result = append(result, i.Key())
+		return true
+	})
+	return result
+}
+
+func TestTreeMap(t *testing.T) {
+	mp := NewTreeMap[int, int](intCompare)
+	reference := make(map[int]int)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		key := rnd.Intn(1000)
+		if rnd.Intn(3) == 0 {
+			mp.Remove(key)
+			delete(reference, key)
+		} else {
+			mp.Put(key, i)
+			reference[key] = i
+		}
+	}
+	common.AssertEq(t, mp.Size(), len(reference))
+	expected := []int{}
+	for k, val := range reference {
+		expected = append(expected, k)
+		got, ok := mp.Get(k)
+		common.AssertTrue(t, ok)
+		common.AssertEq(t, got, val)
+	}
+	sort.Ints(expected)
+	common.AssertArrEq(t, keysOf[int](mp), expected)
+	common.AssertArrEq(t, mp.Keys().ToArray(), expected)
+	PrintMap[int, int](mp.HeadMap(50, false))
+
+	iter := mp.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if next.Key()%2 == 0 {
+			iter.Remove()
+		} else {
+			iter.Set(KVOf(next.Key(), -next.Key()))
+		}
+	}
+	coll.ForEach(mp.Iterator(), func(i KV[int, int]) bool {
+		common.AssertTrue(t, i.Key()%2 == 1)
+		common.AssertEq(t, i.Value(), -i.Key())
+		return true
+	})
+}
+
+func TestTreeMapNavigation(t *testing.T) {
+	mp := NewTreeMap[int, string](intCompare)
+	for i := 10; i <= 50; i += 10 {
+		mp.Put(i, "v")
+	}
+	first, _ := mp.FirstKey()
+	last, _ := mp.LastKey()
+	common.AssertEq(t, first, 10)
+	common.AssertEq(t, last, 50)
+
+	floor, _ := mp.FloorKey(25)
+	ceiling, _ := mp.CeilingKey(25)
+	lower, _ := mp.LowerKey(30)
+	higher, _ := mp.HigherKey(30)
+	common.AssertEq(t, floor, 20)
+	common.AssertEq(t, ceiling, 30)
+	common.AssertEq(t, lower, 20)
+	common.AssertEq(t, higher, 40)
+	_, ok := mp.LowerKey(10)
+	common.AssertFalse(t, ok)
+	_, ok = mp.HigherKey(50)
+	common.AssertFalse(t, ok)
+
+	common.AssertArrEq(t, keysOf[string](mp.HeadMap(30, false)), []int{10, 20})
+	common.AssertArrEq(t, keysOf[string](mp.HeadMap(30, true)), []int{10, 20, 30})
+	common.AssertArrEq(t, keysOf[string](mp.TailMap(30, false)), []int{40, 50})
+	common.AssertArrEq(t, keysOf[string](mp.SubMap(20, true, 40, false)), []int{20, 30})
+	common.AssertArrEq(t, keysOf[string](mp.DescendingMap()), []int{50, 40, 30, 20, 10})
+	common.AssertArrEq(t, keysOf[string](mp.DescendingMap().HeadMap(30, false)), []int{50, 40})
+	common.AssertArrEq(t, keysOf[string](mp.DescendingMap().SubMap(40, true, 10, false)), []int{40, 30, 20})
+
+	descending := mp.DescendingMap()
+	dfloor, _ := descending.FloorKey(25)
+	common.AssertEq(t, dfloor, 30)
+	dfirst, _ := descending.FirstKey()
+	common.AssertEq(t, dfirst, 50)
+
+	// Views are live in both directions
+	sub := mp.SubMap(15, true, 45, true)
+	common.AssertEq(t, sub.Size(), 3)
+	sub.Put(25, "new")
+	common.AssertTrue(t, mp.Contains(25))
+	mp.Put(35, "new")
+	common.AssertEq(t, sub.Size(), 5)
+	sub.Remove(20)
+	common.AssertFalse(t, mp.Contains(20))
+	sub.RemoveAll(sub.Keys())
+	common.AssertArrEq(t, keysOf[string](mp), []int{10, 50})
+}
+
+func TestTreeMapFailFast(t *testing.T) {
+	mp := NewTreeMap[int, int](intCompare)
+	mp.Put(1, 1)
+	mp.Put(2, 2)
+	iter := mp.Iterator()
+	iter.Next()
+	mp.Put(3, 3)
+	assertPanics(t, func() { iter.Next() })
+}
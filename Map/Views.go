@@ -0,0 +1,439 @@
+package Map
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+// An entry of EntrySet(). SetValue() writes through to the map
+type Entry[K comparable, V any] interface {
+	KV[K, V]
+
+	// Set the value of the entry in the map, returns the old value
+	SetValue(value V) (oldValue V)
+}
+
+func addToView() {
+	panic("Unsupported operation: can't add to a map view")
+}
+
+// Returns a live view of the keys of m, built on its Contains, Remove and Iterator.
+// Removing keys from the view, or through its iterator, removes the entries from m. Adding panics
+func KeySet[K comparable, V any](m Map[K, V]) set.Set[K] {
+	return &keySetView[K, V]{m}
+}
+
+// Returns a live view of the values of m, built on its Iterator.
+// Removing values from the view removes the entries from m, Set() of its iterator replaces the value. Adding panics
+func ValuesView[K comparable, V any](m Map[K, V]) coll.Collection[V] {
+	return &valuesView[K, V]{m}
+}
+
+// Returns a live view of the entries of m, built on its Get, Remove and Iterator.
+// Removing entries removes them from m, Entry.SetValue() replaces the value in m. Adding panics.
+// Entries are equal when their keys are equal and their values are equal by DefaultEqualizer
+func EntrySet[K comparable, V any](m Map[K, V]) coll.Collection[Entry[K, V]] {
+	return &entrySetView[K, V]{m}
+}
+
+// Scans what for data
+func containsFunc[T any](what coll.Collection[T], data T, equals coll.Equalizer[T]) bool {
+	found := false
+	what.ForEach(func(i T) bool {
+		found = equals(i, data)
+		return !found
+	})
+	return found
+}
+
+// Implemented by the maps that count modifications, so entries can tell whether their iterator is still valid
+type modCounter interface {
+	modCount() int
+}
+
+// Remove the elements that pass test through iter, returns the number removed
+func removeIf[T any](iter coll.Iterator[T], test func(T) bool) int {
+	count := 0
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if test(next) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
+}
+
+type keySetView[K comparable, V any] struct {
+	m Map[K, V]
+}
+
+func (v *keySetView[K, V]) ForEach(visitor coll.Visitor[K]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *keySetView[K, V]) Add(element K) bool {
+	addToView()
+	return false
+}
+
+func (v *keySetView[K, V]) AddAll(elements coll.Collection[K]) int {
+	addToView()
+	return 0
+}
+
+func (v *keySetView[K, V]) ContainsFunc(what K, equals coll.Equalizer[K]) bool {
+	return containsFunc[K](v, what, equals)
+}
+
+func (v *keySetView[K, V]) Contains(what K) bool {
+	return v.m.Contains(what)
+}
+
+func (v *keySetView[K, V]) IsEmpty() bool {
+	return v.m.Size() == 0
+}
+
+func (v *keySetView[K, V]) Iterator() coll.Iterator[K] {
+	return &keyViewIterator[K, V]{v.m.Iterator()}
+}
+
+func (v *keySetView[K, V]) RemoveAllFunc(collection coll.Collection[K], equals coll.Equalizer[K]) int {
+	return removeIf(v.Iterator(), func(i K) bool {
+		return containsFunc(collection, i, equals)
+	})
+}
+
+func (v *keySetView[K, V]) RemoveAll(collection coll.Collection[K]) int {
+	count := 0
+	collection.ForEach(func(i K) bool {
+		if _, removed := v.m.Remove(i); removed {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *keySetView[K, V]) RetainAllFunc(collection coll.Collection[K], equals coll.Equalizer[K]) int {
+	return removeIf(v.Iterator(), func(i K) bool {
+		return !containsFunc(collection, i, equals)
+	})
+}
+
+func (v *keySetView[K, V]) RetainAll(collection coll.Collection[K]) int {
+	return removeIf(v.Iterator(), func(i K) bool {
+		return !collection.Contains(i)
+	})
+}
+
+func (v *keySetView[K, V]) Size() int {
+	return v.m.Size()
+}
+
+func (v *keySetView[K, V]) ToArray() []K {
+	return coll.ToArray(v.Size(), v.Iterator())
+}
+
+func (v *keySetView[K, V]) Stream() stream.Stream[K] {
+	return stream.FromIterator[K](v.Iterator())
+}
+
+func (v *keySetView[K, V]) Clear() int {
+	return removeIf(v.Iterator(), func(i K) bool {
+		return true
+	})
+}
+
+type keyViewIterator[K comparable, V any] struct {
+	entries coll.Iterator[KV[K, V]]
+}
+
+func (v *keyViewIterator[K, V]) Next() (result K, ok bool) {
+	next, ok := v.entries.Next()
+	if !ok {
+		return result, false
+	}
+	return next.Key(), true
+}
+
+func (v *keyViewIterator[K, V]) Remove() {
+	v.entries.Remove()
+}
+
+func (v *keyViewIterator[K, V]) Set(data K) K {
+	panic("Unsupported operation: can't set a key of a map view")
+}
+
+type valuesView[K comparable, V any] struct {
+	m Map[K, V]
+}
+
+func (v *valuesView[K, V]) ForEach(visitor coll.Visitor[V]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *valuesView[K, V]) Add(element V) bool {
+	addToView()
+	return false
+}
+
+func (v *valuesView[K, V]) AddAll(elements coll.Collection[V]) int {
+	addToView()
+	return 0
+}
+
+func (v *valuesView[K, V]) ContainsFunc(what V, equals coll.Equalizer[V]) bool {
+	return v.m.ContainsValueFunc(what, equals)
+}
+
+func (v *valuesView[K, V]) Contains(what V) bool {
+	return v.m.ContainsValue(what)
+}
+
+func (v *valuesView[K, V]) IsEmpty() bool {
+	return v.m.Size() == 0
+}
+
+func (v *valuesView[K, V]) Iterator() coll.Iterator[V] {
+	return &valueViewIterator[K, V]{entries: v.m.Iterator()}
+}
+
+// Removes every entry whose value is in collection
+func (v *valuesView[K, V]) RemoveAllFunc(collection coll.Collection[V], equals coll.Equalizer[V]) int {
+	return removeIf(v.Iterator(), func(i V) bool {
+		return containsFunc(collection, i, equals)
+	})
+}
+
+func (v *valuesView[K, V]) RemoveAll(collection coll.Collection[V]) int {
+	return removeIf(v.Iterator(), func(i V) bool {
+		return collection.Contains(i)
+	})
+}
+
+func (v *valuesView[K, V]) RetainAllFunc(collection coll.Collection[V], equals coll.Equalizer[V]) int {
+	return removeIf(v.Iterator(), func(i V) bool {
+		return !containsFunc(collection, i, equals)
+	})
+}
+
+func (v *valuesView[K, V]) RetainAll(collection coll.Collection[V]) int {
+	return removeIf(v.Iterator(), func(i V) bool {
+		return !collection.Contains(i)
+	})
+}
+
+func (v *valuesView[K, V]) Size() int {
+	return v.m.Size()
+}
+
+func (v *valuesView[K, V]) ToArray() []V {
+	return coll.ToArray(v.Size(), v.Iterator())
+}
+
+func (v *valuesView[K, V]) Stream() stream.Stream[V] {
+	return stream.FromIterator[V](v.Iterator())
+}
+
+func (v *valuesView[K, V]) Clear() int {
+	return removeIf(v.Iterator(), func(i V) bool {
+		return true
+	})
+}
+
+type valueViewIterator[K comparable, V any] struct {
+	entries coll.Iterator[KV[K, V]]
+	last    KV[K, V]
+}
+
+func (v *valueViewIterator[K, V]) Next() (result V, ok bool) {
+	next, ok := v.entries.Next()
+	if !ok {
+		return result, false
+	}
+	v.last = next
+	return next.Value(), true
+}
+
+func (v *valueViewIterator[K, V]) Remove() {
+	v.entries.Remove()
+}
+
+// Replace the value of the last returned entry
+func (v *valueViewIterator[K, V]) Set(data V) V {
+	if v.last == nil {
+		panic("Don't call set before reading")
+	}
+	return v.entries.Set(KVOf(v.last.Key(), data)).Value()
+}
+
+type entrySetView[K comparable, V any] struct {
+	m Map[K, V]
+}
+
+func (v *entrySetView[K, V]) ForEach(visitor coll.Visitor[Entry[K, V]]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *entrySetView[K, V]) Add(element Entry[K, V]) bool {
+	addToView()
+	return false
+}
+
+func (v *entrySetView[K, V]) AddAll(elements coll.Collection[Entry[K, V]]) int {
+	addToView()
+	return 0
+}
+
+func (v *entrySetView[K, V]) ContainsFunc(what Entry[K, V], equals coll.Equalizer[Entry[K, V]]) bool {
+	return containsFunc[Entry[K, V]](v, what, equals)
+}
+
+// Whether the map has the key of what, with an equal value
+func (v *entrySetView[K, V]) Contains(what Entry[K, V]) bool {
+	value, ok := v.m.Get(what.Key())
+	return ok && coll.DefaultEqualizer[V]()(value, what.Value())
+}
+
+func (v *entrySetView[K, V]) IsEmpty() bool {
+	return v.m.Size() == 0
+}
+
+func (v *entrySetView[K, V]) Iterator() coll.Iterator[Entry[K, V]] {
+	result := &entryViewIterator[K, V]{m: v.m, entries: v.m.Iterator()}
+	result.sync()
+	return result
+}
+
+func (v *entrySetView[K, V]) RemoveAllFunc(collection coll.Collection[Entry[K, V]], equals coll.Equalizer[Entry[K, V]]) int {
+	return removeIf(v.Iterator(), func(i Entry[K, V]) bool {
+		return containsFunc(collection, i, equals)
+	})
+}
+
+func (v *entrySetView[K, V]) RemoveAll(collection coll.Collection[Entry[K, V]]) int {
+	count := 0
+	collection.ForEach(func(i Entry[K, V]) bool {
+		if v.Contains(i) {
+			v.m.Remove(i.Key())
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *entrySetView[K, V]) RetainAllFunc(collection coll.Collection[Entry[K, V]], equals coll.Equalizer[Entry[K, V]]) int {
+	return removeIf(v.Iterator(), func(i Entry[K, V]) bool {
+		return !containsFunc(collection, i, equals)
+	})
+}
+
+func (v *entrySetView[K, V]) RetainAll(collection coll.Collection[Entry[K, V]]) int {
+	return v.RetainAllFunc(collection, coll.DefaultEqualizer[Entry[K, V]]())
+}
+
+func (v *entrySetView[K, V]) Size() int {
+	return v.m.Size()
+}
+
+func (v *entrySetView[K, V]) ToArray() []Entry[K, V] {
+	return coll.ToArray(v.Size(), v.Iterator())
+}
+
+func (v *entrySetView[K, V]) Stream() stream.Stream[Entry[K, V]] {
+	return stream.FromIterator[Entry[K, V]](v.Iterator())
+}
+
+func (v *entrySetView[K, V]) Clear() int {
+	return removeIf(v.Iterator(), func(i Entry[K, V]) bool {
+		return true
+	})
+}
+
+type entryViewIterator[K comparable, V any] struct {
+	m       Map[K, V]
+	entries coll.Iterator[KV[K, V]]
+	// The entry returned by the last Next(), nil after Remove() and at the end
+	last *viewEntry[K, V]
+	// modCount() of m after the last change made through this iterator
+	generation int
+}
+
+func (v *entryViewIterator[K, V]) sync() {
+	if counter, ok := v.m.(modCounter); ok {
+		v.generation = counter.modCount()
+	}
+}
+
+// Whether entry can still be written through this iterator: it is the last one returned,
+// and m has not changed since. Maps that don't count modifications are assumed unchanged
+func (v *entryViewIterator[K, V]) live(entry *viewEntry[K, V]) bool {
+	if v.last != entry {
+		return false
+	}
+	counter, ok := v.m.(modCounter)
+	return !ok || counter.modCount() == v.generation
+}
+
+func (v *entryViewIterator[K, V]) Next() (result Entry[K, V], ok bool) {
+	next, ok := v.entries.Next()
+	if !ok {
+		v.last = nil
+		return result, false
+	}
+	v.last = &viewEntry[K, V]{key: next.Key(), value: next.Value(), m: v.m, iter: v}
+	return v.last, true
+}
+
+func (v *entryViewIterator[K, V]) Remove() {
+	v.entries.Remove()
+	v.last = nil
+	v.sync()
+}
+
+// Replace the value of the last returned entry. The key of data must be the same
+func (v *entryViewIterator[K, V]) Set(data Entry[K, V]) Entry[K, V] {
+	old := v.entries.Set(KVOf(data.Key(), data.Value()))
+	v.sync()
+	if v.last != nil {
+		v.last.value = data.Value()
+	}
+	return &viewEntry[K, V]{key: old.Key(), value: old.Value(), m: v.m}
+}
+
+type viewEntry[K comparable, V any] struct {
+	key   K
+	value V
+	m     Map[K, V]
+	// The iterator that returned the entry, if any
+	iter *entryViewIterator[K, V]
+}
+
+func (v *viewEntry[K, V]) Key() K {
+	return v.key
+}
+
+func (v *viewEntry[K, V]) Value() V {
+	return v.value
+}
+
+// Writes through the iterator while this is its last entry and the map hasn't changed since, so iteration can go on.
+// Otherwise replaces the value in the map. Panics if the key has been removed
+func (v *viewEntry[K, V]) SetValue(value V) (oldValue V) {
+	oldValue = v.value
+	if v.iter != nil && v.iter.live(v) && v.m.Contains(v.key) {
+		v.iter.entries.Set(KVOf(v.key, value))
+		v.iter.sync()
+	} else if _, replaced := v.m.Replace(v.key, value); !replaced {
+		panic("The entry is no longer in the map")
+	}
+	v.value = value
+	return oldValue
+}
+
+// Entries are equal when their keys are equal and their values are equal by DefaultEqualizer
+func (v *viewEntry[K, V]) Equals(other Entry[K, V]) bool {
+	return other != nil && v.key == other.Key() && coll.DefaultEqualizer[V]()(v.value, other.Value())
+}
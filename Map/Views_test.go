@@ -0,0 +1,86 @@
+package Map
+
+import (
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/gojava/common"
+)
+
+func TestViews(t *testing.T) {
+	maps := map[string]Map[string, int]{
+		"HashMap":           NewHashMap[string, int](),
+		"LinkedHashMap":     NewLinkedHashMap[string, int](),
+		"TreeMap":           NewTreeMap[string, int](coll.NaturalOrder[string]()),
+		"ConcurrentHashMap": NewConcurrentHashMap[string, int](),
+	}
+	for name, m := range maps {
+		t.Run(name, func(t *testing.T) {
+			for i, key := range []string{"a", "b", "c", "d", "e"} {
+				m.Put(key, i)
+			}
+			keys := m.KeySet()
+			values := m.ValuesView()
+			entries := m.EntrySet()
+			snapshot := m.Keys()
+
+			common.AssertEq(t, keys.RemoveAll(list.ArrayListOf("a", "z")), 1)
+			common.AssertFalse(t, m.Contains("a"))
+			common.AssertEq(t, snapshot.Size(), 5)
+			common.AssertEq(t, values.Size(), 4)
+
+			values.RemoveAll(list.ArrayListOf(1))
+			common.AssertFalse(t, m.Contains("b"))
+
+			iter := values.Iterator()
+			for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+				iter.Set(next * 10)
+			}
+			common.AssertEq(t, m.GetOrDefault("c", 0), 20)
+
+			entryIter := entries.Iterator()
+			for next, ok := entryIter.Next(); ok; next, ok = entryIter.Next() {
+				if next.Key() == "d" {
+					entryIter.Remove()
+				} else {
+					next.SetValue(next.Value() + 1)
+				}
+			}
+			common.AssertFalse(t, m.Contains("d"))
+			common.AssertEq(t, m.GetOrDefault("e", 0), 41)
+			entryFor := func(key string) (result Entry[string, int]) {
+				entries.ForEach(func(i Entry[string, int]) bool {
+					result = i
+					return i.Key() != key
+				})
+				return
+			}
+			first := entryFor("c")
+			common.AssertTrue(t, entries.Contains(first))
+			// Entries with the same key and value are equal
+			common.AssertTrue(t, list.ArrayListOf(first).Contains(entryFor("c")))
+			first.SetValue(100)
+			common.AssertEq(t, m.GetOrDefault("c", 0), 100)
+
+			// Once iteration is over or the map changed, entries replace the value in the map
+			last := entryFor("")
+			m.Put("z", 1)
+			last.SetValue(5)
+			common.AssertEq(t, m.GetOrDefault(last.Key(), 0), 5)
+			// but don't put back a removed key
+			m.Remove(first.Key())
+			assertPanics(t, func() { first.SetValue(1) })
+			common.AssertFalse(t, m.Contains("c"))
+			m.Put("c", 100)
+			m.Remove("z")
+
+			keys.Clear()
+			common.AssertEq(t, m.Size(), 0)
+			common.AssertTrue(t, entries.IsEmpty())
+			m.Put("x", 1)
+			common.AssertTrue(t, keys.Contains("x"))
+			assertPanics(t, func() { keys.Add("y") })
+		})
+	}
+}
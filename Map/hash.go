@@ -0,0 +1,8 @@
+package Map
+
+import "github.com/wushilin/gojava/internal/hashing"
+
+// Hash a comparable value, so that equal values (by ==) have equal hashes
+func hashOf[K comparable](key K) uint64 {
+	return hashing.Comparable(key)
+}
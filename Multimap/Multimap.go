@@ -0,0 +1,208 @@
+package Multimap
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	mp "github.com/wushilin/gojava/Map"
+	multiset "github.com/wushilin/gojava/Multiset"
+	set "github.com/wushilin/gojava/Set"
+)
+
+// Maps each key to a collection of values. Keys with no values are not kept in the backing map,
+// the collections are created on the first Put and removed when they become empty
+type multimap[K comparable, V any, C coll.Collection[V]] struct {
+	data          mp.Map[K, C]
+	newCollection func() C
+}
+
+// Number of values, not keys
+func (v *multimap[K, V, C]) Size() int {
+	count := 0
+	v.data.ForEach(func(key K, values C) bool {
+		count += values.Size()
+		return true
+	})
+	return count
+}
+
+func (v *multimap[K, V, C]) IsEmpty() bool {
+	return v.data.Size() == 0
+}
+
+// Whether key has at least one value
+func (v *multimap[K, V, C]) ContainsKey(key K) bool {
+	return v.data.Contains(key)
+}
+
+// Whether any key has value
+func (v *multimap[K, V, C]) ContainsValue(value V) bool {
+	found := false
+	v.data.ForEach(func(key K, values C) bool {
+		found = values.Contains(value)
+		return !found
+	})
+	return found
+}
+
+// Whether value is one of the values of key
+func (v *multimap[K, V, C]) ContainsEntry(key K, value V) bool {
+	values, ok := v.data.Get(key)
+	return ok && values.Contains(value)
+}
+
+// Add value to the values of key. Returns whether the multimap changed
+func (v *multimap[K, V, C]) Put(key K, value V) bool {
+	values, ok := v.data.Get(key)
+	if !ok {
+		values = v.newCollection()
+	}
+	added := values.Add(value)
+	if !ok && added {
+		v.data.Put(key, values)
+	}
+	return added
+}
+
+// Add all values to the values of key. Returns the number added
+func (v *multimap[K, V, C]) PutAll(key K, values coll.Collection[V]) int {
+	current, ok := v.data.Get(key)
+	if !ok {
+		current = v.newCollection()
+	}
+	added := current.AddAll(values)
+	if !ok && added > 0 {
+		v.data.Put(key, current)
+	}
+	return added
+}
+
+// Remove all values of key. Returns the values that were removed, the collection is no longer part of the multimap
+func (v *multimap[K, V, C]) RemoveAll(key K) C {
+	if values, ok := v.data.Remove(key); ok {
+		return values
+	}
+	return v.newCollection()
+}
+
+// Removes all keys and values, returns the number of values removed
+func (v *multimap[K, V, C]) Clear() int {
+	count := v.Size()
+	v.data.KeySet().Clear()
+	return count
+}
+
+// Returns a snapshot of the key value pairs, one for each value
+func (v *multimap[K, V, C]) Entries() coll.Collection[mp.KV[K, V]] {
+	result := list.NewArrayList[mp.KV[K, V]]()
+	v.ForEach(func(key K, value V) bool {
+		result.Add(mp.KVOf(key, value))
+		return true
+	})
+	return result
+}
+
+// Returns a snapshot of the keys as a Multiset, the count of a key is its number of values
+func (v *multimap[K, V, C]) Keys() multiset.Multiset[K] {
+	result := multiset.NewLinkedHashMultiset[K]()
+	v.data.ForEach(func(key K, values C) bool {
+		result.AddN(key, values.Size())
+		return true
+	})
+	return result
+}
+
+// Returns a live view of the keys that have values. Removing a key removes all of its values
+func (v *multimap[K, V, C]) KeySet() set.Set[K] {
+	return v.data.KeySet()
+}
+
+// Returns a snapshot of all values
+func (v *multimap[K, V, C]) Values() coll.Collection[V] {
+	result := list.NewArrayList[V]()
+	v.ForEach(func(key K, value V) bool {
+		result.Add(value)
+		return true
+	})
+	return result
+}
+
+// Returns the backing map from key to its values. Changes write through, don't put empty collections into it
+func (v *multimap[K, V, C]) AsMap() mp.Map[K, C] {
+	return v.data
+}
+
+// Visit each key value pair. When visitor returns false, it stops. Returns the number of pairs visited
+func (v *multimap[K, V, C]) ForEach(visitor func(key K, value V) bool) int {
+	count := 0
+	v.data.ForEach(func(key K, values C) bool {
+		stopped := false
+		count += values.ForEach(func(value V) bool {
+			stopped = !visitor(key, value)
+			return !stopped
+		})
+		return !stopped
+	})
+	return count
+}
+
+// Multimap that keeps the values of a key in a List, so a key can have the same value more than once
+type ListMultimap[K comparable, V any] struct {
+	multimap[K, V, list.List[V]]
+}
+
+// A ListMultimap backed by a HashMap with ArrayList values
+func NewListMultimap[K comparable, V any]() *ListMultimap[K, V] {
+	return &ListMultimap[K, V]{multimap[K, V, list.List[V]]{mp.NewHashMap[K, list.List[V]](), newArrayList[V]}}
+}
+
+// A ListMultimap that iterates keys in insertion order, backed by a LinkedHashMap with ArrayList values
+func NewLinkedListMultimap[K comparable, V any]() *ListMultimap[K, V] {
+	return &ListMultimap[K, V]{multimap[K, V, list.List[V]]{mp.NewLinkedHashMap[K, list.List[V]](), newArrayList[V]}}
+}
+
+func newArrayList[V any]() list.List[V] {
+	return list.NewArrayList[V]()
+}
+
+// Returns a live view of the values of key, empty if key has none. Adding to the view adds to the multimap
+func (v *ListMultimap[K, V]) Get(key K) list.List[V] {
+	return &listView[K, V]{view[K, V, list.List[V]]{m: &v.multimap, key: key}}
+}
+
+// Remove the first occurrence of value from the values of key. Returns whether it was found
+func (v *ListMultimap[K, V]) Remove(key K, value V) bool {
+	return v.Get(key).RemoveFirst(value)
+}
+
+// Multimap that keeps the values of a key in a Set, so a key has each value at most once
+type SetMultimap[K comparable, V comparable] struct {
+	multimap[K, V, set.Set[V]]
+}
+
+// A SetMultimap backed by a HashMap with HashSet values
+func NewSetMultimap[K comparable, V comparable]() *SetMultimap[K, V] {
+	return &SetMultimap[K, V]{multimap[K, V, set.Set[V]]{mp.NewHashMap[K, set.Set[V]](), newHashSet[V]}}
+}
+
+// A SetMultimap that iterates keys and values in insertion order, backed by a LinkedHashMap with LinkedHashSet values
+func NewLinkedSetMultimap[K comparable, V comparable]() *SetMultimap[K, V] {
+	return &SetMultimap[K, V]{multimap[K, V, set.Set[V]]{mp.NewLinkedHashMap[K, set.Set[V]](), newLinkedHashSet[V]}}
+}
+
+func newHashSet[V comparable]() set.Set[V] {
+	return set.NewHashSet[V]()
+}
+
+func newLinkedHashSet[V comparable]() set.Set[V] {
+	return set.NewLinkedHashSet[V]()
+}
+
+// Returns a live view of the values of key, empty if key has none. Adding to the view adds to the multimap
+func (v *SetMultimap[K, V]) Get(key K) set.Set[V] {
+	return &view[K, V, set.Set[V]]{m: &v.multimap, key: key}
+}
+
+// Remove value from the values of key. Returns whether it was found
+func (v *SetMultimap[K, V]) Remove(key K, value V) bool {
+	return v.Get(key).RemoveAll(set.HashSetOf(value)) > 0
+}
@@ -0,0 +1,98 @@
+package Multimap
+
+import (
+	"testing"
+
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/gojava/common"
+)
+
+func TestListMultimap(t *testing.T) {
+	m := NewLinkedListMultimap[string, int]()
+	common.AssertTrue(t, m.Put("b", 1))
+	common.AssertTrue(t, m.Put("a", 2))
+	common.AssertTrue(t, m.Put("b", 1))
+	common.AssertEq(t, m.PutAll("a", list.ArrayListOf(3, 4)), 2)
+	common.AssertEq(t, m.Size(), 5)
+	common.AssertArrEq(t, m.KeySet().ToArray(), []string{"b", "a"})
+	common.AssertArrEq(t, m.Keys().ToArray(), []string{"b", "b", "a", "a", "a"})
+	common.AssertEq(t, m.Keys().Count("a"), 3)
+	common.AssertArrEq(t, m.Values().ToArray(), []int{1, 1, 2, 3, 4})
+	common.AssertTrue(t, m.ContainsEntry("a", 3))
+	common.AssertFalse(t, m.ContainsEntry("b", 3))
+	common.AssertEq(t, m.Entries().Size(), 5)
+
+	common.AssertTrue(t, m.Remove("b", 1))
+	common.AssertArrEq(t, m.Get("b").ToArray(), []int{1})
+	common.AssertArrEq(t, m.RemoveAll("a").ToArray(), []int{2, 3, 4})
+	common.AssertFalse(t, m.ContainsKey("a"))
+	common.AssertEq(t, m.Size(), 1)
+	common.AssertEq(t, m.RemoveAll("z").Size(), 0)
+}
+
+func TestListMultimapGetView(t *testing.T) {
+	m := NewListMultimap[string, int]()
+	values := m.Get("a")
+	common.AssertTrue(t, values.IsEmpty())
+	common.AssertFalse(t, m.ContainsKey("a"))
+
+	values.Add(1)
+	values.AddAt(0, 0)
+	common.AssertTrue(t, m.ContainsKey("a"))
+	common.AssertArrEq(t, m.AsMap().GetOrDefault("a", nil).ToArray(), []int{0, 1})
+
+	values.RemoveAt(0)
+	values.RemoveFirst(1)
+	common.AssertFalse(t, m.ContainsKey("a"))
+	common.AssertTrue(t, m.IsEmpty())
+
+	values.AddAll(list.ArrayListOf(1, 2, 3))
+	iter := values.Iterator()
+	for _, ok := iter.Next(); ok; _, ok = iter.Next() {
+		iter.Remove()
+	}
+	common.AssertFalse(t, m.ContainsKey("a"))
+
+	listIter := values.ListIterator()
+	listIter.Add(5)
+	common.AssertArrEq(t, m.Get("a").ToArray(), []int{5})
+
+	values.Add(6)
+	sub := values.SubList(0, 1)
+	sub.Clear()
+	common.AssertArrEq(t, values.ToArray(), []int{6})
+	values.SubList(0, 1).Clear()
+	common.AssertFalse(t, m.ContainsKey("a"))
+
+	m.Get("b").SubList(0, 0).Add(7)
+	common.AssertArrEq(t, m.Get("b").ToArray(), []int{7})
+	common.AssertEq(t, m.Clear(), 1)
+	common.AssertTrue(t, m.IsEmpty())
+}
+
+func TestSetMultimap(t *testing.T) {
+	m := NewLinkedSetMultimap[string, int]()
+	common.AssertTrue(t, m.Put("a", 1))
+	common.AssertFalse(t, m.Put("a", 1))
+	common.AssertEq(t, m.PutAll("a", list.ArrayListOf(1, 2, 3)), 2)
+	m.Put("b", 3)
+	common.AssertEq(t, m.Size(), 4)
+	common.AssertTrue(t, m.ContainsValue(3))
+	common.AssertArrEq(t, m.Get("a").ToArray(), []int{1, 2, 3})
+
+	common.AssertTrue(t, m.Remove("b", 3))
+	common.AssertFalse(t, m.Remove("b", 3))
+	common.AssertFalse(t, m.ContainsKey("b"))
+
+	m.Get("a").RetainAll(list.ArrayListOf(2))
+	common.AssertArrEq(t, m.Get("a").ToArray(), []int{2})
+	m.KeySet().RemoveAll(list.ArrayListOf("a"))
+	common.AssertTrue(t, m.IsEmpty())
+
+	hashed := NewSetMultimap[int, int]()
+	hashed.Get(1).Add(1)
+	count := hashed.ForEach(func(key int, value int) bool {
+		return false
+	})
+	common.AssertEq(t, count, 1)
+}
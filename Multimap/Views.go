@@ -0,0 +1,245 @@
+package Multimap
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/stream"
+)
+
+// Live view of the values of a key, returned by Get(). Every call looks up the current collection of the key,
+// so the view keeps working after the key is removed and added back.
+// A key without values reads as an empty collection, which is put into the map once something is added to it.
+// Removing the last value removes the key
+type view[K comparable, V any, C coll.Collection[V]] struct {
+	m   *multimap[K, V, C]
+	key K
+	// For SubList views, the sublist and the list it belongs to
+	sub   C
+	root  C
+	isSub bool
+}
+
+// The current values, a new empty collection if key has none
+func (v *view[K, V, C]) values() C {
+	if v.isSub {
+		return v.sub
+	}
+	if values, ok := v.m.data.Get(v.key); ok {
+		return values
+	}
+	return v.m.newCollection()
+}
+
+// Bring the map in line with values after a change: drop the key when it has become empty, put it when it was new
+func (v *view[K, V, C]) sync(values C) {
+	if v.isSub {
+		values = v.root
+	}
+	current, ok := v.m.data.Get(v.key)
+	if values.IsEmpty() {
+		if ok && any(current) == any(values) {
+			v.m.data.Remove(v.key)
+		}
+		return
+	}
+	if !ok {
+		v.m.data.Put(v.key, values)
+	}
+}
+
+func (v *view[K, V, C]) ForEach(visitor coll.Visitor[V]) int {
+	return v.values().ForEach(visitor)
+}
+
+func (v *view[K, V, C]) Add(element V) bool {
+	values := v.values()
+	defer v.sync(values)
+	return values.Add(element)
+}
+
+func (v *view[K, V, C]) AddAll(elements coll.Collection[V]) int {
+	values := v.values()
+	defer v.sync(values)
+	return values.AddAll(elements)
+}
+
+func (v *view[K, V, C]) ContainsFunc(what V, equals coll.Equalizer[V]) bool {
+	return v.values().ContainsFunc(what, equals)
+}
+
+func (v *view[K, V, C]) Contains(data V) bool {
+	return v.values().Contains(data)
+}
+
+func (v *view[K, V, C]) IsEmpty() bool {
+	return v.values().IsEmpty()
+}
+
+func (v *view[K, V, C]) Iterator() coll.Iterator[V] {
+	values := v.values()
+	return &viewIterator[K, V, C]{values.Iterator(), v, values}
+}
+
+func (v *view[K, V, C]) RemoveAllFunc(collection coll.Collection[V], equals coll.Equalizer[V]) int {
+	values := v.values()
+	defer v.sync(values)
+	return values.RemoveAllFunc(collection, equals)
+}
+
+func (v *view[K, V, C]) RemoveAll(collection coll.Collection[V]) int {
+	values := v.values()
+	defer v.sync(values)
+	return values.RemoveAll(collection)
+}
+
+func (v *view[K, V, C]) RetainAllFunc(collection coll.Collection[V], equals coll.Equalizer[V]) int {
+	values := v.values()
+	defer v.sync(values)
+	return values.RetainAllFunc(collection, equals)
+}
+
+func (v *view[K, V, C]) RetainAll(collection coll.Collection[V]) int {
+	values := v.values()
+	defer v.sync(values)
+	return values.RetainAll(collection)
+}
+
+func (v *view[K, V, C]) Size() int {
+	return v.values().Size()
+}
+
+func (v *view[K, V, C]) ToArray() []V {
+	return v.values().ToArray()
+}
+
+func (v *view[K, V, C]) Stream() stream.Stream[V] {
+	return stream.FromIterator[V](v.Iterator())
+}
+
+func (v *view[K, V, C]) Clear() int {
+	values := v.values()
+	defer v.sync(values)
+	return values.Clear()
+}
+
+type viewIterator[K comparable, V any, C coll.Collection[V]] struct {
+	coll.Iterator[V]
+	view   *view[K, V, C]
+	values C
+}
+
+func (v *viewIterator[K, V, C]) Remove() {
+	v.Iterator.Remove()
+	v.view.sync(v.values)
+}
+
+// The List view of ListMultimap.Get()
+type listView[K comparable, V any] struct {
+	view[K, V, list.List[V]]
+}
+
+func (v *listView[K, V]) AddAt(index int, element V) bool {
+	values := v.values()
+	defer v.sync(values)
+	return values.AddAt(index, element)
+}
+
+func (v *listView[K, V]) AddAllAt(index int, elements coll.Collection[V]) int {
+	values := v.values()
+	defer v.sync(values)
+	return values.AddAllAt(index, elements)
+}
+
+func (v *listView[K, V]) Get(index int) V {
+	return v.values().Get(index)
+}
+
+func (v *listView[K, V]) IndexOfFunc(what V, equalizer coll.Equalizer[V]) int {
+	return v.values().IndexOfFunc(what, equalizer)
+}
+
+func (v *listView[K, V]) IndexOf(what V) int {
+	return v.values().IndexOf(what)
+}
+
+func (v *listView[K, V]) LastIndexOfFunc(what V, equalizer coll.Equalizer[V]) int {
+	return v.values().LastIndexOfFunc(what, equalizer)
+}
+
+func (v *listView[K, V]) LastIndexOf(what V) int {
+	return v.values().LastIndexOf(what)
+}
+
+func (v *listView[K, V]) Set(index int, newValue V) V {
+	return v.values().Set(index, newValue)
+}
+
+func (v *listView[K, V]) RemoveFirstFunc(data V, equals coll.Equalizer[V]) bool {
+	values := v.values()
+	defer v.sync(values)
+	return values.RemoveFirstFunc(data, equals)
+}
+
+func (v *listView[K, V]) RemoveFirst(data V) bool {
+	values := v.values()
+	defer v.sync(values)
+	return values.RemoveFirst(data)
+}
+
+func (v *listView[K, V]) RemoveAt(index int) V {
+	values := v.values()
+	defer v.sync(values)
+	return values.RemoveAt(index)
+}
+
+func (v *listView[K, V]) CopySubList(fromIndexIncluded int, endIndexExcluded int) list.List[V] {
+	return v.values().CopySubList(fromIndexIncluded, endIndexExcluded)
+}
+
+// A live view of a range of the values. It stays attached to the list of values the key had when it was created
+func (v *listView[K, V]) SubList(fromIndexIncluded int, endIndexExcluded int) list.List[V] {
+	values := v.values()
+	root := values
+	if v.isSub {
+		root = v.root
+	}
+	sub := values.SubList(fromIndexIncluded, endIndexExcluded)
+	return &listView[K, V]{view[K, V, list.List[V]]{m: v.m, key: v.key, sub: sub, root: root, isSub: true}}
+}
+
+func (v *listView[K, V]) Copy() list.List[V] {
+	return v.values().Copy()
+}
+
+func (v *listView[K, V]) Reverse() list.List[V] {
+	return v.values().Reverse()
+}
+
+func (v *listView[K, V]) Sort(comparator coll.Comparator[V]) {
+	v.values().Sort(comparator)
+}
+
+func (v *listView[K, V]) ListIterator() list.ListIterator[V] {
+	return v.ListIteratorAt(0)
+}
+
+func (v *listView[K, V]) ListIteratorAt(index int) list.ListIterator[V] {
+	values := v.values()
+	return &viewListIterator[K, V]{values.ListIteratorAt(index), &v.view, values}
+}
+
+type viewListIterator[K comparable, V any] struct {
+	list.ListIterator[V]
+	view   *view[K, V, list.List[V]]
+	values list.List[V]
+}
+
+func (v *viewListIterator[K, V]) Remove() {
+	v.ListIterator.Remove()
+	v.view.sync(v.values)
+}
+
+func (v *viewListIterator[K, V]) Add(element V) {
+	v.ListIterator.Add(element)
+	v.view.sync(v.values)
+}
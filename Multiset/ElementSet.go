@@ -0,0 +1,150 @@
+package Multiset
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/stream"
+)
+
+// Live view of the distinct elements of a Multiset. Removing an element removes all of its occurrences
+type elementSet[T comparable] struct {
+	src *counts[T]
+}
+
+func addToView() {
+	panic("Unsupported operation: can't add to an element set view")
+}
+
+func (v *elementSet[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *elementSet[T]) Add(element T) bool {
+	addToView()
+	return false
+}
+
+func (v *elementSet[T]) AddAll(elements coll.Collection[T]) int {
+	addToView()
+	return 0
+}
+
+func (v *elementSet[T]) ContainsFunc(what T, equals coll.Equalizer[T]) bool {
+	return v.src.ContainsFunc(what, equals)
+}
+
+func (v *elementSet[T]) Contains(data T) bool {
+	return v.src.Contains(data)
+}
+
+func (v *elementSet[T]) IsEmpty() bool {
+	return v.src.IsEmpty()
+}
+
+func (v *elementSet[T]) Iterator() coll.Iterator[T] {
+	return &elementSetIterator[T]{src: v.src, generation: v.src.generation, elements: v.src.elements()}
+}
+
+// Remove the elements that pass test, returns the number of elements removed
+func (v *elementSet[T]) removeIf(test func(T) bool) int {
+	count := 0
+	for _, next := range v.src.elements() {
+		if test(next) {
+			v.src.SetCount(next, 0)
+			count++
+		}
+	}
+	return count
+}
+
+func (v *elementSet[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.removeIf(func(i T) bool {
+		return containsFunc(collection, i, equals)
+	})
+}
+
+func (v *elementSet[T]) RemoveAll(collection coll.Collection[T]) int {
+	return v.removeIf(collection.Contains)
+}
+
+func (v *elementSet[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.removeIf(func(i T) bool {
+		return !containsFunc(collection, i, equals)
+	})
+}
+
+func (v *elementSet[T]) RetainAll(collection coll.Collection[T]) int {
+	return v.removeIf(func(i T) bool {
+		return !collection.Contains(i)
+	})
+}
+
+func (v *elementSet[T]) Size() int {
+	return v.src.data.Size()
+}
+
+func (v *elementSet[T]) ToArray() []T {
+	return v.src.elements()
+}
+
+func (v *elementSet[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *elementSet[T]) Clear() int {
+	old := v.Size()
+	v.src.Clear()
+	return old
+}
+
+type elementSetIterator[T comparable] struct {
+	src        *counts[T]
+	generation int
+	elements   []T
+	index      int
+}
+
+func (v *elementSetIterator[T]) checkMod() {
+	if v.generation != v.src.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *elementSetIterator[T]) Next() (result T, ok bool) {
+	v.checkMod()
+	if v.index >= len(v.elements) {
+		return result, false
+	}
+	v.index++
+	return v.elements[v.index-1], true
+}
+
+// Removes all occurrences of the last element
+func (v *elementSetIterator[T]) Remove() {
+	v.checkMod()
+	if v.index == 0 || !v.src.Contains(v.elements[v.index-1]) {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	v.src.SetCount(v.elements[v.index-1], 0)
+	v.generation = v.src.generation
+}
+
+// Replaces the last element with data, which takes over its count.
+// Panics if data is another element of the multiset, since the two would merge
+func (v *elementSetIterator[T]) Set(data T) T {
+	v.checkMod()
+	if v.index == 0 || !v.src.Contains(v.elements[v.index-1]) {
+		panic("Don't call set before reading, or after remove")
+	}
+	old := v.elements[v.index-1]
+	if data == old {
+		return old
+	}
+	if v.src.Contains(data) {
+		panic("Element already in the multiset")
+	}
+	count := v.src.SetCount(old, 0)
+	v.src.AddN(data, count)
+	v.elements[v.index-1] = data
+	v.generation = v.src.generation
+	return old
+}
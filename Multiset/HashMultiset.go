@@ -0,0 +1,37 @@
+package Multiset
+
+import mp "github.com/wushilin/gojava/Map"
+
+// A Multiset that keeps its counts in a HashMap, elements are iterated in no particular order
+type HashMultiset[T comparable] struct {
+	counts[T]
+}
+
+func NewHashMultiset[T comparable]() *HashMultiset[T] {
+	return &HashMultiset[T]{counts[T]{data: mp.NewHashMap[T, int]()}}
+}
+
+func HashMultisetOf[T comparable](args ...T) *HashMultiset[T] {
+	result := NewHashMultiset[T]()
+	for _, next := range args {
+		result.Add(next)
+	}
+	return result
+}
+
+// A Multiset that iterates elements in the order they were first added, counts are kept in a LinkedHashMap
+type LinkedHashMultiset[T comparable] struct {
+	counts[T]
+}
+
+func NewLinkedHashMultiset[T comparable]() *LinkedHashMultiset[T] {
+	return &LinkedHashMultiset[T]{counts[T]{data: mp.NewLinkedHashMap[T, int]()}}
+}
+
+func LinkedHashMultisetOf[T comparable](args ...T) *LinkedHashMultiset[T] {
+	result := NewLinkedHashMultiset[T]()
+	for _, next := range args {
+		result.Add(next)
+	}
+	return result
+}
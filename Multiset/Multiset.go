@@ -0,0 +1,61 @@
+package Multiset
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	set "github.com/wushilin/gojava/Set"
+)
+
+// A collection that counts how many times each element occurs, also known as a bag.
+// Size() counts occurrences, Iterator() returns each element once per occurrence
+type Multiset[T comparable] interface {
+	coll.Collection[T]
+
+	// Add occurrences of element, returns the count before
+	AddN(element T, occurrences int) (previousCount int)
+
+	// Remove one occurrence of element, returns whether there was one
+	Remove(element T) (removed bool)
+
+	// Remove up to occurrences of element, returns the count before
+	RemoveN(element T, occurrences int) (previousCount int)
+
+	// Number of occurrences of element, 0 if absent
+	Count(element T) int
+
+	// Set the number of occurrences of element, 0 removes it. Returns the count before
+	SetCount(element T, count int) (previousCount int)
+
+	// Returns a live view of the distinct elements. Removing an element from it removes all of its occurrences
+	ElementSet() set.Set[T]
+
+	// Returns a snapshot of the distinct elements with their counts
+	EntrySet() coll.Collection[Entry[T]]
+
+	// Returns the n elements with the highest counts, highest first. Equal counts keep the iteration order.
+	// n < 0 returns all of them
+	MostCommon(n int) []Entry[T]
+}
+
+// An element of a Multiset with its count
+type Entry[T any] interface {
+	Element() T
+	Count() int
+}
+
+type entry[T any] struct {
+	element T
+	count   int
+}
+
+func (v *entry[T]) Element() T {
+	return v.element
+}
+
+func (v *entry[T]) Count() int {
+	return v.count
+}
+
+// Returns an Entry of element and count
+func EntryOf[T any](element T, count int) Entry[T] {
+	return &entry[T]{element, count}
+}
@@ -0,0 +1,138 @@
+package Multiset
+
+import (
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/gojava/common"
+)
+
+func assertPanics(t *testing.T, f func()) {
+	defer func() {
+		common.AssertTrue(t, recover() != nil)
+	}()
+	f()
+	t.Fatal("Expect panic")
+}
+
+var _ Multiset[int] = NewHashMultiset[int]()
+var _ Multiset[int] = NewLinkedHashMultiset[int]()
+var _ Multiset[int] = NewTreeMultiset[int](coll.NaturalOrder[int]())
+
+func TestMultiset(t *testing.T) {
+	multisets := map[string]Multiset[string]{
+		"HashMultiset":       NewHashMultiset[string](),
+		"LinkedHashMultiset": NewLinkedHashMultiset[string](),
+		"TreeMultiset":       NewTreeMultiset[string](coll.NaturalOrder[string]()),
+	}
+	for name, m := range multisets {
+		t.Run(name, func(t *testing.T) {
+			common.AssertEq(t, m.AddN("a", 3), 0)
+			common.AssertEq(t, m.AddN("a", 2), 3)
+			m.Add("b")
+			common.AssertEq(t, m.Size(), 6)
+			common.AssertEq(t, m.Count("a"), 5)
+			common.AssertEq(t, m.Count("z"), 0)
+
+			common.AssertEq(t, m.RemoveN("a", 2), 5)
+			common.AssertTrue(t, m.Remove("b"))
+			common.AssertFalse(t, m.Remove("b"))
+			common.AssertFalse(t, m.Contains("b"))
+			common.AssertEq(t, m.RemoveN("a", 10), 3)
+			common.AssertTrue(t, m.IsEmpty())
+
+			common.AssertEq(t, m.SetCount("c", 2), 0)
+			common.AssertEq(t, m.SetCount("c", 1), 2)
+			common.AssertEq(t, m.AddAll(list.ArrayListOf("d", "d", "e")), 3)
+			common.AssertEq(t, m.Size(), 4)
+			common.AssertEq(t, m.ElementSet().Size(), 3)
+			common.AssertEq(t, m.EntrySet().Size(), 3)
+
+			common.AssertEq(t, m.RemoveAll(list.ArrayListOf("d")), 2)
+			common.AssertEq(t, m.Size(), 2)
+			common.AssertEq(t, m.Clear(), 2)
+			common.AssertEq(t, m.Size(), 0)
+		})
+	}
+}
+
+func TestMultisetIterator(t *testing.T) {
+	m := LinkedHashMultisetOf("a", "b", "a", "c", "b", "a")
+	common.AssertArrEq(t, m.ToArray(), []string{"a", "a", "a", "b", "b", "c"})
+
+	iter := m.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if next == "a" {
+			iter.Remove()
+		}
+	}
+	common.AssertFalse(t, m.Contains("a"))
+	common.AssertEq(t, m.Size(), 3)
+
+	assertPanics(t, func() {
+		iter := m.Iterator()
+		iter.Next()
+		m.Add("x")
+		iter.Next()
+	})
+}
+
+func TestElementSet(t *testing.T) {
+	m := TreeMultisetOf(coll.NaturalOrder[int](), 3, 1, 2, 1, 3, 3)
+	elements := m.ElementSet()
+	common.AssertArrEq(t, elements.ToArray(), []int{1, 2, 3})
+	first, _ := m.First()
+	last, _ := m.Last()
+	common.AssertEq(t, first, 1)
+	common.AssertEq(t, last, 3)
+
+	common.AssertEq(t, elements.RemoveAll(list.ArrayListOf(3)), 1)
+	common.AssertEq(t, m.Size(), 3)
+	iter := elements.Iterator()
+	iter.Next()
+	iter.Remove()
+	common.AssertEq(t, m.Count(1), 0)
+	common.AssertArrEq(t, m.ToArray(), []int{2})
+	m.Add(4)
+	common.AssertArrEq(t, elements.ToArray(), []int{2, 4})
+
+	iter = elements.Iterator()
+	iter.Next()
+	common.AssertEq(t, iter.Set(5), 2)
+	common.AssertEq(t, m.Count(5), 1)
+	assertPanics(t, func() { iter.Set(4) })
+	common.AssertEq(t, m.Size(), 2)
+	next, _ := iter.Next()
+	common.AssertEq(t, next, 4)
+	_, ok := iter.Next()
+	common.AssertFalse(t, ok)
+
+	// Set after Remove has no element to replace
+	iter.Remove()
+	assertPanics(t, func() { iter.Set(6) })
+	common.AssertArrEq(t, m.ToArray(), []int{5})
+
+	// The Func methods scan with equals, whatever the collection does
+	sameParity := func(a, b int) bool {
+		return a%2 == b%2
+	}
+	m.AddN(2, 2)
+	common.AssertEq(t, elements.RemoveAllFunc(set.HashSetOf(4), sameParity), 1)
+	common.AssertArrEq(t, m.ToArray(), []int{5})
+	m.Add(2)
+	common.AssertEq(t, m.RetainAllFunc(set.HashSetOf(7), sameParity), 1)
+	common.AssertArrEq(t, m.ToArray(), []int{5})
+}
+
+func TestMostCommon(t *testing.T) {
+	m := LinkedHashMultisetOf("x", "y", "y", "z", "z", "z", "w", "w")
+	top := m.MostCommon(2)
+	common.AssertEq(t, len(top), 2)
+	common.AssertEq(t, top[0].Element(), "z")
+	common.AssertEq(t, top[0].Count(), 3)
+	common.AssertEq(t, top[1].Element(), "y")
+	common.AssertEq(t, len(m.MostCommon(-1)), 4)
+	common.AssertEq(t, len(m.MostCommon(10)), 4)
+}
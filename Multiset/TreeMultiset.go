@@ -0,0 +1,35 @@
+package Multiset
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	mp "github.com/wushilin/gojava/Map"
+)
+
+// A Multiset that iterates elements in comparator order, counts are kept in a TreeMap
+type TreeMultiset[T comparable] struct {
+	counts[T]
+	tree *mp.TreeMap[T, int]
+}
+
+func NewTreeMultiset[T comparable](comparator coll.Comparator[T]) *TreeMultiset[T] {
+	tree := mp.NewTreeMap[T, int](comparator)
+	return &TreeMultiset[T]{counts[T]{data: tree}, tree}
+}
+
+func TreeMultisetOf[T comparable](comparator coll.Comparator[T], args ...T) *TreeMultiset[T] {
+	result := NewTreeMultiset(comparator)
+	for _, next := range args {
+		result.Add(next)
+	}
+	return result
+}
+
+// Return the lowest element, if multiset is empty, ok is set to false
+func (v *TreeMultiset[T]) First() (element T, ok bool) {
+	return v.tree.FirstKey()
+}
+
+// Return the highest element, if multiset is empty, ok is set to false
+func (v *TreeMultiset[T]) Last() (element T, ok bool) {
+	return v.tree.LastKey()
+}
@@ -0,0 +1,268 @@
+package Multiset
+
+import (
+	"sort"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	mp "github.com/wushilin/gojava/Map"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+// The Multiset implementation shared by the variants, they differ in the Map that holds the counts
+type counts[T comparable] struct {
+	data       mp.Map[T, int]
+	size       int
+	generation int
+}
+
+func (v *counts[T]) applyMod() {
+	v.generation++
+}
+
+func checkOccurrences(occurrences int) {
+	if occurrences < 0 {
+		panic("Occurrences can't be negative")
+	}
+}
+
+// Snapshot of the distinct elements
+func (v *counts[T]) elements() []T {
+	result := make([]T, 0, v.data.Size())
+	v.data.ForEach(func(key T, count int) bool {
+		result = append(result, key)
+		return true
+	})
+	return result
+}
+
+func (v *counts[T]) Count(element T) int {
+	return v.data.GetOrDefault(element, 0)
+}
+
+func (v *counts[T]) SetCount(element T, count int) int {
+	checkOccurrences(count)
+	previous := v.Count(element)
+	if previous == count {
+		return previous
+	}
+	defer v.applyMod()
+	if count == 0 {
+		v.data.Remove(element)
+	} else {
+		v.data.Put(element, count)
+	}
+	v.size += count - previous
+	return previous
+}
+
+func (v *counts[T]) AddN(element T, occurrences int) int {
+	checkOccurrences(occurrences)
+	previous := v.Count(element)
+	v.SetCount(element, previous+occurrences)
+	return previous
+}
+
+func (v *counts[T]) RemoveN(element T, occurrences int) int {
+	checkOccurrences(occurrences)
+	previous := v.Count(element)
+	if occurrences > previous {
+		occurrences = previous
+	}
+	v.SetCount(element, previous-occurrences)
+	return previous
+}
+
+func (v *counts[T]) Remove(element T) bool {
+	return v.RemoveN(element, 1) > 0
+}
+
+func (v *counts[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *counts[T]) Add(element T) bool {
+	v.AddN(element, 1)
+	return true
+}
+
+func (v *counts[T]) AddAll(elements coll.Collection[T]) int {
+	return elements.ForEach(func(i T) bool {
+		v.Add(i)
+		return true
+	})
+}
+
+func (v *counts[T]) ContainsFunc(what T, equals coll.Equalizer[T]) bool {
+	found := false
+	v.data.ForEach(func(key T, count int) bool {
+		found = equals(key, what)
+		return !found
+	})
+	return found
+}
+
+func (v *counts[T]) Contains(data T) bool {
+	return v.data.Contains(data)
+}
+
+func (v *counts[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+func (v *counts[T]) Iterator() coll.Iterator[T] {
+	return &MultisetIterator[T]{src: v, generation: v.generation, elements: v.elements()}
+}
+
+// Scans what for data, without relying on what.ContainsFunc honoring equals
+func containsFunc[T any](what coll.Collection[T], data T, equals coll.Equalizer[T]) bool {
+	found := false
+	what.ForEach(func(i T) bool {
+		found = equals(i, data)
+		return !found
+	})
+	return found
+}
+
+// Remove every occurrence of the elements that pass test, returns the number of occurrences removed
+func (v *counts[T]) removeIf(test func(T) bool) int {
+	count := 0
+	for _, next := range v.elements() {
+		if test(next) {
+			count += v.SetCount(next, 0)
+		}
+	}
+	return count
+}
+
+// Removes all occurrences of the elements in collection
+func (v *counts[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.removeIf(func(i T) bool {
+		return containsFunc(collection, i, equals)
+	})
+}
+
+// Removes all occurrences of the elements in collection
+func (v *counts[T]) RemoveAll(collection coll.Collection[T]) int {
+	count := 0
+	collection.ForEach(func(i T) bool {
+		count += v.SetCount(i, 0)
+		return true
+	})
+	return count
+}
+
+func (v *counts[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.removeIf(func(i T) bool {
+		return !containsFunc(collection, i, equals)
+	})
+}
+
+func (v *counts[T]) RetainAll(collection coll.Collection[T]) int {
+	return v.removeIf(func(i T) bool {
+		return !collection.Contains(i)
+	})
+}
+
+func (v *counts[T]) Size() int {
+	return v.size
+}
+
+func (v *counts[T]) ToArray() []T {
+	return coll.ToArray(v.size, v.Iterator())
+}
+
+func (v *counts[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *counts[T]) Clear() int {
+	defer v.applyMod()
+	old := v.size
+	v.data.KeySet().Clear()
+	v.size = 0
+	return old
+}
+
+func (v *counts[T]) ElementSet() set.Set[T] {
+	return &elementSet[T]{v}
+}
+
+func (v *counts[T]) EntrySet() coll.Collection[Entry[T]] {
+	result := list.NewArrayList[Entry[T]]()
+	v.data.ForEach(func(key T, count int) bool {
+		result.Add(EntryOf(key, count))
+		return true
+	})
+	return result
+}
+
+func (v *counts[T]) MostCommon(n int) []Entry[T] {
+	result := v.EntrySet().ToArray()
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Count() > result[j].Count()
+	})
+	if n >= 0 && n < len(result) {
+		result = result[:n]
+	}
+	return result
+}
+
+// Iterates a snapshot of the distinct elements, the counts are read in realtime
+type MultisetIterator[T comparable] struct {
+	src        *counts[T]
+	generation int
+	elements   []T
+	index      int
+	remaining  int
+	last       T
+	hasLast    bool
+}
+
+func (v *MultisetIterator[T]) checkMod() {
+	if v.generation != v.src.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *MultisetIterator[T]) Next() (result T, ok bool) {
+	v.checkMod()
+	for v.remaining == 0 {
+		if v.index >= len(v.elements) {
+			v.hasLast = false
+			return result, false
+		}
+		v.remaining = v.src.Count(v.elements[v.index])
+		v.index++
+	}
+	v.remaining--
+	v.last = v.elements[v.index-1]
+	v.hasLast = true
+	return v.last, true
+}
+
+// Removes one occurrence of the last element
+func (v *MultisetIterator[T]) Remove() {
+	v.checkMod()
+	if !v.hasLast {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	v.src.Remove(v.last)
+	v.hasLast = false
+	v.generation = v.src.generation
+}
+
+// Replaces one occurrence of the last element with data
+func (v *MultisetIterator[T]) Set(data T) T {
+	v.checkMod()
+	if !v.hasLast {
+		panic("Don't call set before reading")
+	}
+	old := v.last
+	v.src.Remove(old)
+	v.src.Add(data)
+	v.last = data
+	v.generation = v.src.generation
+	return old
+}
@@ -0,0 +1,275 @@
+package Queue
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/stream"
+)
+
+// A deque backed by a ring buffer. The buffer doubles when full, it has no capacity limit
+type ArrayDeque[T any] struct {
+	buffer     []T
+	head       int
+	size       int
+	generation int
+}
+
+func (v *ArrayDeque[T]) applyMod() {
+	v.generation++
+}
+
+// Convert logical index to buffer index
+func (v *ArrayDeque[T]) slot(index int) int {
+	return (v.head + index) % len(v.buffer)
+}
+
+func (v *ArrayDeque[T]) grow() {
+	if v.size < len(v.buffer) {
+		return
+	}
+	newBuffer := make([]T, len(v.buffer)*2+1)
+	for i := 0; i < v.size; i++ {
+		newBuffer[i] = v.buffer[v.slot(i)]
+	}
+	v.buffer = newBuffer
+	v.head = 0
+}
+
+func (v *ArrayDeque[T]) get(index int) T {
+	return v.buffer[v.slot(index)]
+}
+
+// Remove element at logical index, shifting the following elements one slot to the left
+func (v *ArrayDeque[T]) removeAt(index int) T {
+	result := v.get(index)
+	for i := index; i < v.size-1; i++ {
+		v.buffer[v.slot(i)] = v.buffer[v.slot(i+1)]
+	}
+	var zv T
+	v.buffer[v.slot(v.size-1)] = zv
+	v.size--
+	return result
+}
+
+func (v *ArrayDeque[T]) Size() int {
+	return v.size
+}
+
+func (v *ArrayDeque[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+func (v *ArrayDeque[T]) Add(element T) bool {
+	return v.OfferLast(element)
+}
+
+func (v *ArrayDeque[T]) AddAll(elements coll.Collection[T]) int {
+	count := 0
+	elements.ForEach(func(i T) bool {
+		if v.Add(i) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *ArrayDeque[T]) Offer(element T) bool {
+	return v.OfferLast(element)
+}
+
+func (v *ArrayDeque[T]) Poll() (T, bool) {
+	return v.PollFirst()
+}
+
+func (v *ArrayDeque[T]) Peek() (T, bool) {
+	return v.PeekFirst()
+}
+
+func (v *ArrayDeque[T]) OfferFirst(element T) bool {
+	defer v.applyMod()
+	v.grow()
+	v.head = (v.head - 1 + len(v.buffer)) % len(v.buffer)
+	v.buffer[v.head] = element
+	v.size++
+	return true
+}
+
+func (v *ArrayDeque[T]) OfferLast(element T) bool {
+	defer v.applyMod()
+	v.grow()
+	v.buffer[v.slot(v.size)] = element
+	v.size++
+	return true
+}
+
+func (v *ArrayDeque[T]) PollFirst() (result T, ok bool) {
+	if v.size == 0 {
+		return
+	}
+	defer v.applyMod()
+	var zv T
+	result = v.buffer[v.head]
+	v.buffer[v.head] = zv
+	v.head = (v.head + 1) % len(v.buffer)
+	v.size--
+	return result, true
+}
+
+func (v *ArrayDeque[T]) PollLast() (result T, ok bool) {
+	if v.size == 0 {
+		return
+	}
+	defer v.applyMod()
+	var zv T
+	last := v.slot(v.size - 1)
+	result = v.buffer[last]
+	v.buffer[last] = zv
+	v.size--
+	return result, true
+}
+
+func (v *ArrayDeque[T]) PeekFirst() (result T, ok bool) {
+	if v.size == 0 {
+		return
+	}
+	return v.get(0), true
+}
+
+func (v *ArrayDeque[T]) PeekLast() (result T, ok bool) {
+	if v.size == 0 {
+		return
+	}
+	return v.get(v.size - 1), true
+}
+
+func (v *ArrayDeque[T]) Push(element T) {
+	v.OfferFirst(element)
+}
+
+func (v *ArrayDeque[T]) Pop() (T, bool) {
+	return v.PollFirst()
+}
+
+func (v *ArrayDeque[T]) Clear() int {
+	defer v.applyMod()
+	old := v.size
+	v.buffer = make([]T, len(v.buffer))
+	v.head = 0
+	v.size = 0
+	return old
+}
+
+func (v *ArrayDeque[T]) Contains(data T) bool {
+	return v.ContainsFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *ArrayDeque[T]) ContainsFunc(data T, equals coll.Equalizer[T]) bool {
+	return list.FindItem(v.Iterator(), data, equals) != -1
+}
+
+func (v *ArrayDeque[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *ArrayDeque[T]) RemoveAll(collection coll.Collection[T]) int {
+	return v.RemoveAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *ArrayDeque[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return list.RemoveAllFunc[T](v, collection, equals)
+}
+
+func (v *ArrayDeque[T]) RetainAll(collection coll.Collection[T]) int {
+	return v.RetainAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *ArrayDeque[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return list.RetainAllFunc[T](v, collection, equals)
+}
+
+func (v *ArrayDeque[T]) Iterator() coll.Iterator[T] {
+	return &ArrayDequeIterator[T]{Src: v, currentIndex: 0, lastReturnedIndex: -1, generation: v.generation}
+}
+
+func (v *ArrayDeque[T]) DescendingIterator() coll.Iterator[T] {
+	return &ArrayDequeIterator[T]{Src: v, currentIndex: v.size - 1, lastReturnedIndex: -1, generation: v.generation, descending: true}
+}
+
+func (v *ArrayDeque[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *ArrayDeque[T]) ToArray() []T {
+	return coll.ToArray(v.Size(), v.Iterator())
+}
+
+type ArrayDequeIterator[T any] struct {
+	Src               *ArrayDeque[T]
+	currentIndex      int
+	lastReturnedIndex int
+	generation        int
+	descending        bool
+}
+
+func (v *ArrayDequeIterator[T]) checkMod() {
+	if v.generation != v.Src.generation {
+		panic("Concurrent Modification detected")
+	}
+}
+
+func (v *ArrayDequeIterator[T]) applyMod() {
+	v.generation++
+	v.Src.generation = v.generation
+}
+
+func (v *ArrayDequeIterator[T]) Next() (val T, ok bool) {
+	v.checkMod()
+	if v.currentIndex < 0 || v.currentIndex >= v.Src.size {
+		return val, false
+	}
+	result := v.Src.get(v.currentIndex)
+	v.lastReturnedIndex = v.currentIndex
+	if v.descending {
+		v.currentIndex--
+	} else {
+		v.currentIndex++
+	}
+	return result, true
+}
+
+func (v *ArrayDequeIterator[T]) Remove() {
+	v.checkMod()
+	if v.lastReturnedIndex == -1 {
+		panic("Don't call Remove when you have not read, or you have removed")
+	}
+	defer v.applyMod()
+	v.Src.removeAt(v.lastReturnedIndex)
+	if !v.descending {
+		v.currentIndex--
+	}
+	v.lastReturnedIndex = -1
+}
+
+func (v *ArrayDequeIterator[T]) Set(data T) T {
+	v.checkMod()
+	if v.lastReturnedIndex == -1 {
+		panic("Don't call Set when you have not read, or you have removed")
+	}
+	defer v.applyMod()
+	slot := v.Src.slot(v.lastReturnedIndex)
+	old := v.Src.buffer[slot]
+	v.Src.buffer[slot] = data
+	return old
+}
+
+// Return new empty ArrayDeque[T]
+func NewArrayDeque[T any]() *ArrayDeque[T] {
+	return &ArrayDeque[T]{buffer: make([]T, 16), head: 0, size: 0}
+}
+
+func ArrayDequeOf[T any](args ...T) *ArrayDeque[T] {
+	result := NewArrayDeque[T]()
+	coll.AddElementsTo[T](result, args...)
+	return result
+}
@@ -0,0 +1,399 @@
+package Queue
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/stream"
+)
+
+// A Queue that is safe for concurrent use, and can wait for space when adding, or for elements when reading.
+// Offer, Poll and Add never block, they fail when the queue is full or empty
+type BlockingQueue[T any] interface {
+	Queue[T]
+
+	// Adds element to the tail, waiting for space if the queue is full.
+	// Returns ctx.Err() if ctx is done before there is space
+	Put(ctx context.Context, element T) error
+
+	// Removes and returns the head, waiting for an element if the queue is empty.
+	// Returns ctx.Err() if ctx is done before an element is available
+	Take(ctx context.Context) (element T, err error)
+
+	// Adds element to the tail, waiting up to timeout for space. Returns false if timed out
+	OfferTimeout(element T, timeout time.Duration) (added bool)
+
+	// Removes and returns the head, waiting up to timeout for an element. ok is set to false if timed out
+	PollTimeout(timeout time.Duration) (element T, ok bool)
+
+	// Returns the number of elements that can be added without blocking
+	RemainingCapacity() int
+
+	// Removes at most max elements (all if max < 0) from the head and adds them to target.
+	// Returns the number of elements moved. If target rejects an element, it stops and puts the rest back at the head.
+	// Panics if target is this queue
+	DrainTo(target coll.Collection[T], max int) int
+}
+
+// Elements are boxed, so iterators can find the exact element they returned
+type blockingEntry[T any] struct {
+	value T
+}
+
+type blockingQueue[T any] struct {
+	lock     sync.Mutex
+	buffer   Deque[*blockingEntry[T]]
+	capacity int
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+// Wake up all goroutines waiting on signal
+func (v *blockingQueue[T]) signal(signal *chan struct{}) {
+	if *signal != nil {
+		close(*signal)
+		*signal = nil
+	}
+}
+
+// Wait until ready returns true. Lock must be held, and it is held again when await returns
+func (v *blockingQueue[T]) await(ctx context.Context, ready func() bool, signal *chan struct{}) error {
+	for !ready() {
+		if *signal == nil {
+			*signal = make(chan struct{})
+		}
+		wait := *signal
+		v.lock.Unlock()
+		select {
+		case <-wait:
+			v.lock.Lock()
+		case <-ctx.Done():
+			v.lock.Lock()
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (v *blockingQueue[T]) hasSpace() bool {
+	return v.buffer.Size() < v.capacity
+}
+
+func (v *blockingQueue[T]) hasElement() bool {
+	return v.buffer.Size() > 0
+}
+
+func (v *blockingQueue[T]) enqueue(element T) {
+	v.buffer.OfferLast(&blockingEntry[T]{value: element})
+	v.signal(&v.notEmpty)
+}
+
+func (v *blockingQueue[T]) dequeue() T {
+	entry, _ := v.buffer.PollFirst()
+	v.signal(&v.notFull)
+	return entry.value
+}
+
+func (v *blockingQueue[T]) Size() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.buffer.Size()
+}
+
+func (v *blockingQueue[T]) IsEmpty() bool {
+	return v.Size() == 0
+}
+
+func (v *blockingQueue[T]) RemainingCapacity() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return v.capacity - v.buffer.Size()
+}
+
+// Same as Offer, returns false if the queue is full
+func (v *blockingQueue[T]) Add(element T) bool {
+	return v.Offer(element)
+}
+
+func (v *blockingQueue[T]) AddAll(elements coll.Collection[T]) int {
+	count := 0
+	elements.ForEach(func(i T) bool {
+		if v.Add(i) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *blockingQueue[T]) Offer(element T) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if !v.hasSpace() {
+		return false
+	}
+	v.enqueue(element)
+	return true
+}
+
+func (v *blockingQueue[T]) Poll() (result T, ok bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if !v.hasElement() {
+		return
+	}
+	return v.dequeue(), true
+}
+
+func (v *blockingQueue[T]) Peek() (result T, ok bool) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	entry, ok := v.buffer.PeekFirst()
+	if !ok {
+		return
+	}
+	return entry.value, true
+}
+
+func (v *blockingQueue[T]) Put(ctx context.Context, element T) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if err := v.await(ctx, v.hasSpace, &v.notFull); err != nil {
+		return err
+	}
+	v.enqueue(element)
+	return nil
+}
+
+func (v *blockingQueue[T]) Take(ctx context.Context) (result T, err error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if err = v.await(ctx, v.hasElement, &v.notEmpty); err != nil {
+		return
+	}
+	return v.dequeue(), nil
+}
+
+func (v *blockingQueue[T]) OfferTimeout(element T, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return v.Put(ctx, element) == nil
+}
+
+func (v *blockingQueue[T]) PollTimeout(timeout time.Duration) (T, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	result, err := v.Take(ctx)
+	return result, err == nil
+}
+
+func (v *blockingQueue[T]) self() *blockingQueue[T] {
+	return v
+}
+
+// Panics if target is this queue. The elements are added to target after the lock is released.
+// When target rejects an element, it and the ones after it are put back at the head, in order
+func (v *blockingQueue[T]) DrainTo(target coll.Collection[T], max int) int {
+	if other, ok := target.(interface{ self() *blockingQueue[T] }); ok && other.self() == v {
+		panic("Can't drain a queue to itself")
+	}
+	drained := v.drain(max)
+	for index, next := range drained {
+		if !target.Add(next) {
+			v.restore(drained[index:])
+			return index
+		}
+	}
+	return len(drained)
+}
+
+func (v *blockingQueue[T]) drain(max int) []T {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	result := []T{}
+	for v.hasElement() && (max < 0 || len(result) < max) {
+		result = append(result, v.dequeue())
+	}
+	return result
+}
+
+// Put elements back at the head, even if that goes over capacity
+func (v *blockingQueue[T]) restore(elements []T) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	for i := len(elements) - 1; i >= 0; i-- {
+		v.buffer.OfferFirst(&blockingEntry[T]{value: elements[i]})
+	}
+	v.signal(&v.notEmpty)
+}
+
+func (v *blockingQueue[T]) Clear() int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	count := v.buffer.Clear()
+	v.signal(&v.notFull)
+	return count
+}
+
+func (v *blockingQueue[T]) Contains(data T) bool {
+	return v.ContainsFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *blockingQueue[T]) ContainsFunc(data T, equals coll.Equalizer[T]) bool {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	found := false
+	v.buffer.ForEach(func(entry *blockingEntry[T]) bool {
+		found = equals(entry.value, data)
+		return !found
+	})
+	return found
+}
+
+// Remove elements for which remove returns true, while holding the lock
+func (v *blockingQueue[T]) removeIf(remove func(T) bool) int {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	count := 0
+	iter := v.buffer.Iterator()
+	for entry, ok := iter.Next(); ok; entry, ok = iter.Next() {
+		if remove(entry.value) {
+			iter.Remove()
+			count++
+		}
+	}
+	if count > 0 {
+		v.signal(&v.notFull)
+	}
+	return count
+}
+
+func (v *blockingQueue[T]) RemoveAll(collection coll.Collection[T]) int {
+	return v.RemoveAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *blockingQueue[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.removeIf(func(i T) bool {
+		return collection.ContainsFunc(i, equals)
+	})
+}
+
+func (v *blockingQueue[T]) RetainAll(collection coll.Collection[T]) int {
+	return v.RetainAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *blockingQueue[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.removeIf(func(i T) bool {
+		return !collection.ContainsFunc(i, equals)
+	})
+}
+
+func (v *blockingQueue[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+// Returns a weakly consistent iterator over a snapshot of the queue. It never panics because of
+// concurrent modification. Remove() removes the returned element if it is still in the queue
+func (v *blockingQueue[T]) Iterator() coll.Iterator[T] {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	return &BlockingQueueIterator[T]{src: v, entries: v.buffer.ToArray()}
+}
+
+func (v *blockingQueue[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *blockingQueue[T]) ToArray() []T {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	result := make([]T, 0, v.buffer.Size())
+	v.buffer.ForEach(func(entry *blockingEntry[T]) bool {
+		result = append(result, entry.value)
+		return true
+	})
+	return result
+}
+
+type BlockingQueueIterator[T any] struct {
+	src          *blockingQueue[T]
+	entries      []*blockingEntry[T]
+	currentIndex int
+	lastReturned *blockingEntry[T]
+}
+
+func (v *BlockingQueueIterator[T]) Next() (result T, ok bool) {
+	if v.currentIndex >= len(v.entries) {
+		return result, false
+	}
+	v.lastReturned = v.entries[v.currentIndex]
+	v.currentIndex++
+	v.src.lock.Lock()
+	defer v.src.lock.Unlock()
+	return v.lastReturned.value, true
+}
+
+func (v *BlockingQueueIterator[T]) Remove() {
+	if v.lastReturned == nil {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	target := v.lastReturned
+	v.lastReturned = nil
+	v.src.lock.Lock()
+	defer v.src.lock.Unlock()
+	iter := v.src.buffer.Iterator()
+	for entry, ok := iter.Next(); ok; entry, ok = iter.Next() {
+		if entry == target {
+			iter.Remove()
+			v.src.signal(&v.src.notFull)
+			return
+		}
+	}
+}
+
+func (v *BlockingQueueIterator[T]) Set(data T) T {
+	if v.lastReturned == nil {
+		panic("Don't call set before reading")
+	}
+	v.src.lock.Lock()
+	defer v.src.lock.Unlock()
+	old := v.lastReturned.value
+	v.lastReturned.value = data
+	return old
+}
+
+// A bounded blocking queue backed by a ring buffer that is allocated up front
+type ArrayBlockingQueue[T any] struct {
+	blockingQueue[T]
+}
+
+// A blocking queue backed by a linked list, optionally bounded
+type LinkedBlockingQueue[T any] struct {
+	blockingQueue[T]
+}
+
+// Return new empty ArrayBlockingQueue[T] that holds at most capacity elements
+func NewArrayBlockingQueue[T any](capacity int) *ArrayBlockingQueue[T] {
+	if capacity < 1 {
+		panic("Capacity must be positive")
+	}
+	buffer := &ArrayDeque[*blockingEntry[T]]{buffer: make([]*blockingEntry[T], capacity)}
+	return &ArrayBlockingQueue[T]{blockingQueue[T]{buffer: buffer, capacity: capacity}}
+}
+
+// Return new empty LinkedBlockingQueue[T] without capacity limit. Put never blocks
+func NewLinkedBlockingQueue[T any]() *LinkedBlockingQueue[T] {
+	return NewLinkedBlockingQueueWithCapacity[T](math.MaxInt)
+}
+
+// Return new empty LinkedBlockingQueue[T] that holds at most capacity elements
+func NewLinkedBlockingQueueWithCapacity[T any](capacity int) *LinkedBlockingQueue[T] {
+	if capacity < 1 {
+		panic("Capacity must be positive")
+	}
+	return &LinkedBlockingQueue[T]{blockingQueue[T]{buffer: list.NewLinkedList[*blockingEntry[T]](), capacity: capacity}}
+}
@@ -0,0 +1,145 @@
+package Queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/gojava/common"
+)
+
+var _ BlockingQueue[int] = NewArrayBlockingQueue[int](1)
+var _ BlockingQueue[int] = NewLinkedBlockingQueue[int]()
+
+func testBlockingQueue(t *testing.T, q BlockingQueue[int]) {
+	// Producers and consumers running together must see every element exactly once
+	var wg sync.WaitGroup
+	results := make(chan int, 4000)
+	for g := 0; g < 4; g++ {
+		wg.Add(2)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				if err := q.Put(context.Background(), g*1000+i); err != nil {
+					t.Error(err)
+				}
+			}
+		}(g)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				next, err := q.Take(context.Background())
+				if err != nil {
+					t.Error(err)
+				}
+				results <- next
+			}
+		}()
+	}
+	wg.Wait()
+	close(results)
+	seen := map[int]bool{}
+	for next := range results {
+		seen[next] = true
+	}
+	common.AssertEq(t, len(seen), 4000)
+	common.AssertTrue(t, q.IsEmpty())
+
+	_, ok := q.PollTimeout(10 * time.Millisecond)
+	common.AssertFalse(t, ok)
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	_, err := q.Take(ctx)
+	common.AssertTrue(t, err == context.Canceled)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		q.Offer(42)
+	}()
+	next, ok := q.PollTimeout(time.Minute)
+	common.AssertTrue(t, ok)
+	common.AssertEq(t, next, 42)
+
+	for i := 0; i < 10; i++ {
+		q.Add(i)
+	}
+	common.AssertTrue(t, q.Contains(5))
+	iter := q.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if next%2 == 1 {
+			iter.Remove()
+		}
+		// Iterators work on a snapshot, modifying the queue doesn't break them
+		q.Offer(100)
+	}
+	common.AssertEq(t, q.RemoveAll(list.ArrayListOf(100)), 10)
+	common.AssertArrEq(t, q.ToArray(), []int{0, 2, 4, 6, 8})
+
+	target := list.NewArrayList[int]()
+	common.AssertEq(t, q.DrainTo(target, 2), 2)
+	common.AssertArrEq(t, target.ToArray(), []int{0, 2})
+	common.AssertEq(t, q.DrainTo(target, -1), 3)
+	common.AssertArrEq(t, target.ToArray(), []int{0, 2, 4, 6, 8})
+	common.AssertTrue(t, q.IsEmpty())
+
+	q.Offer(1)
+	panicked := false
+	func() {
+		defer func() {
+			panicked = recover() != nil
+		}()
+		q.DrainTo(q, -1)
+	}()
+	common.AssertTrue(t, panicked)
+	common.AssertEq(t, q.Size(), 1)
+	q.Clear()
+
+	for i := 0; i < 5; i++ {
+		q.Offer(i)
+	}
+	bounded := NewArrayBlockingQueue[int](2)
+	common.AssertEq(t, q.DrainTo(bounded, -1), 2)
+	common.AssertArrEq(t, bounded.ToArray(), []int{0, 1})
+	common.AssertArrEq(t, q.ToArray(), []int{2, 3, 4})
+	q.Clear()
+}
+
+func TestArrayBlockingQueue(t *testing.T) {
+	q := NewArrayBlockingQueue[int](16)
+	testBlockingQueue(t, q)
+
+	for i := 0; i < 16; i++ {
+		common.AssertTrue(t, q.Offer(i))
+	}
+	common.AssertEq(t, q.RemainingCapacity(), 0)
+	common.AssertFalse(t, q.Add(16))
+	common.AssertFalse(t, q.OfferTimeout(16, 10*time.Millisecond))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	common.AssertTrue(t, q.Put(ctx, 16) == context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		q.Poll()
+	}()
+	common.AssertTrue(t, q.Put(context.Background(), 16) == nil)
+	head, _ := q.Peek()
+	common.AssertEq(t, head, 1)
+	common.AssertEq(t, q.Size(), 16)
+}
+
+func TestLinkedBlockingQueue(t *testing.T) {
+	testBlockingQueue(t, NewLinkedBlockingQueueWithCapacity[int](16))
+
+	q := NewLinkedBlockingQueue[int]()
+	testBlockingQueue(t, q)
+	for i := 0; i < 10000; i++ {
+		common.AssertTrue(t, q.Put(context.Background(), i) == nil)
+	}
+	common.AssertEq(t, q.Size(), 10000)
+}
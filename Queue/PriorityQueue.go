@@ -0,0 +1,328 @@
+package Queue
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/stream"
+)
+
+// Refers to an element in a PriorityQueue, so its priority can be changed or it can be removed
+// without searching the queue
+type PriorityQueueHandle[T any] struct {
+	value T
+	index int
+}
+
+// Return the element this handle refers to
+func (v *PriorityQueueHandle[T]) Value() T {
+	return v.value
+}
+
+// Tests whether the element is still in the queue
+func (v *PriorityQueueHandle[T]) InQueue() bool {
+	return v.index >= 0
+}
+
+// A queue backed by a binary heap. The head is the least element according to the comparator.
+// Iterator(), ToArray() and Stream() visit elements in no particular order
+type PriorityQueue[T any] struct {
+	heap       []*PriorityQueueHandle[T]
+	comparator coll.Comparator[T]
+	generation int
+}
+
+func (v *PriorityQueue[T]) applyMod() {
+	v.generation++
+}
+
+func (v *PriorityQueue[T]) less(i, j int) bool {
+	return v.comparator(v.heap[i].value, v.heap[j].value) < 0
+}
+
+func (v *PriorityQueue[T]) swap(i, j int) {
+	v.heap[i], v.heap[j] = v.heap[j], v.heap[i]
+	v.heap[i].index = i
+	v.heap[j].index = j
+}
+
+// Move element at index up, returns true if it moved
+func (v *PriorityQueue[T]) siftUp(index int) bool {
+	start := index
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !v.less(index, parent) {
+			break
+		}
+		v.swap(index, parent)
+		index = parent
+	}
+	return index != start
+}
+
+// Move element at index down, returns true if it moved
+func (v *PriorityQueue[T]) siftDown(index int) bool {
+	start := index
+	n := len(v.heap)
+	for {
+		smallest := index
+		left := 2*index + 1
+		right := left + 1
+		if left < n && v.less(left, smallest) {
+			smallest = left
+		}
+		if right < n && v.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == index {
+			break
+		}
+		v.swap(index, smallest)
+		index = smallest
+	}
+	return index != start
+}
+
+func (v *PriorityQueue[T]) heapify() {
+	for i := len(v.heap)/2 - 1; i >= 0; i-- {
+		v.siftDown(i)
+	}
+}
+
+// Remove element at index. The last element takes its place.
+// Returns the handle of the last element if it was moved before index, otherwise nil
+func (v *PriorityQueue[T]) removeAt(index int) *PriorityQueueHandle[T] {
+	last := len(v.heap) - 1
+	removed := v.heap[index]
+	removed.index = -1
+	if index == last {
+		v.heap[last] = nil
+		v.heap = v.heap[:last]
+		return nil
+	}
+	moved := v.heap[last]
+	v.heap[index] = moved
+	moved.index = index
+	v.heap[last] = nil
+	v.heap = v.heap[:last]
+	if !v.siftDown(index) && v.siftUp(index) {
+		return moved
+	}
+	return nil
+}
+
+func (v *PriorityQueue[T]) Size() int {
+	return len(v.heap)
+}
+
+func (v *PriorityQueue[T]) IsEmpty() bool {
+	return len(v.heap) == 0
+}
+
+func (v *PriorityQueue[T]) Add(element T) bool {
+	return v.Offer(element)
+}
+
+func (v *PriorityQueue[T]) AddAll(elements coll.Collection[T]) int {
+	count := 0
+	elements.ForEach(func(i T) bool {
+		if v.Add(i) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *PriorityQueue[T]) Offer(element T) bool {
+	v.OfferHandle(element)
+	return true
+}
+
+// Same as Offer, but returns a handle that can be passed to Update and RemoveHandle
+func (v *PriorityQueue[T]) OfferHandle(element T) *PriorityQueueHandle[T] {
+	defer v.applyMod()
+	handle := &PriorityQueueHandle[T]{value: element, index: len(v.heap)}
+	v.heap = append(v.heap, handle)
+	v.siftUp(handle.index)
+	return handle
+}
+
+func (v *PriorityQueue[T]) Poll() (result T, ok bool) {
+	if len(v.heap) == 0 {
+		return
+	}
+	defer v.applyMod()
+	result = v.heap[0].value
+	v.removeAt(0)
+	return result, true
+}
+
+func (v *PriorityQueue[T]) Peek() (result T, ok bool) {
+	if len(v.heap) == 0 {
+		return
+	}
+	return v.heap[0].value, true
+}
+
+// Replace the element referred by handle with newValue and restore the heap order.
+// This is how priority is increased or decreased. Panics if the element is no longer in the queue
+func (v *PriorityQueue[T]) Update(handle *PriorityQueueHandle[T], newValue T) {
+	if !v.owns(handle) {
+		panic("Handle is not in this queue")
+	}
+	defer v.applyMod()
+	handle.value = newValue
+	if !v.siftUp(handle.index) {
+		v.siftDown(handle.index)
+	}
+}
+
+// Remove the element referred by handle. Returns false if it is no longer in the queue
+func (v *PriorityQueue[T]) RemoveHandle(handle *PriorityQueueHandle[T]) bool {
+	if !v.owns(handle) {
+		return false
+	}
+	defer v.applyMod()
+	v.removeAt(handle.index)
+	return true
+}
+
+func (v *PriorityQueue[T]) owns(handle *PriorityQueueHandle[T]) bool {
+	return handle != nil && handle.index >= 0 && handle.index < len(v.heap) && v.heap[handle.index] == handle
+}
+
+func (v *PriorityQueue[T]) Clear() int {
+	defer v.applyMod()
+	old := len(v.heap)
+	for _, handle := range v.heap {
+		handle.index = -1
+	}
+	v.heap = nil
+	return old
+}
+
+func (v *PriorityQueue[T]) Contains(data T) bool {
+	return v.ContainsFunc(data, coll.DefaultEqualizer[T]())
+}
+
+func (v *PriorityQueue[T]) ContainsFunc(data T, equals coll.Equalizer[T]) bool {
+	return list.FindItem(v.Iterator(), data, equals) != -1
+}
+
+func (v *PriorityQueue[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *PriorityQueue[T]) RemoveAll(collection coll.Collection[T]) int {
+	return v.RemoveAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *PriorityQueue[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return list.RemoveAllFunc[T](v, collection, equals)
+}
+
+func (v *PriorityQueue[T]) RetainAll(collection coll.Collection[T]) int {
+	return v.RetainAllFunc(collection, coll.DefaultEqualizer[T]())
+}
+
+func (v *PriorityQueue[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	return list.RetainAllFunc[T](v, collection, equals)
+}
+
+func (v *PriorityQueue[T]) Iterator() coll.Iterator[T] {
+	return &PriorityQueueIterator[T]{Src: v, currentIndex: 0, lastReturnedIndex: -1, generation: v.generation}
+}
+
+func (v *PriorityQueue[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *PriorityQueue[T]) ToArray() []T {
+	return coll.ToArray(v.Size(), v.Iterator())
+}
+
+// Iterates the heap array. Removing an element may move a not yet visited element before the cursor,
+// such elements are remembered and visited at the end.
+type PriorityQueueIterator[T any] struct {
+	Src               *PriorityQueue[T]
+	currentIndex      int
+	lastReturnedIndex int
+	lastReturned      *PriorityQueueHandle[T]
+	forgetMeNot       []*PriorityQueueHandle[T]
+	generation        int
+}
+
+func (v *PriorityQueueIterator[T]) checkMod() {
+	if v.generation != v.Src.generation {
+		panic("Concurrent Modification detected")
+	}
+}
+
+func (v *PriorityQueueIterator[T]) applyMod() {
+	v.generation++
+	v.Src.generation = v.generation
+}
+
+func (v *PriorityQueueIterator[T]) Next() (val T, ok bool) {
+	v.checkMod()
+	if v.currentIndex < len(v.Src.heap) {
+		v.lastReturnedIndex = v.currentIndex
+		v.currentIndex++
+		return v.Src.heap[v.lastReturnedIndex].value, true
+	}
+	if len(v.forgetMeNot) > 0 {
+		v.lastReturnedIndex = -1
+		v.lastReturned = v.forgetMeNot[0]
+		v.forgetMeNot = v.forgetMeNot[1:]
+		return v.lastReturned.value, true
+	}
+	return val, false
+}
+
+func (v *PriorityQueueIterator[T]) Remove() {
+	v.checkMod()
+	defer v.applyMod()
+	if v.lastReturnedIndex != -1 {
+		moved := v.Src.removeAt(v.lastReturnedIndex)
+		v.lastReturnedIndex = -1
+		if moved == nil {
+			v.currentIndex--
+		} else {
+			v.forgetMeNot = append(v.forgetMeNot, moved)
+		}
+	} else if v.lastReturned != nil {
+		v.Src.removeAt(v.lastReturned.index)
+		v.lastReturned = nil
+	} else {
+		panic("Don't call Remove when you have not read, or you have removed")
+	}
+}
+
+// Not supported, changing an element would reorder the heap under the iterator. Use Update with a handle instead
+func (v *PriorityQueueIterator[T]) Set(data T) T {
+	panic("PriorityQueue iterator does not support Set")
+}
+
+// Return new empty PriorityQueue[T]. The head is the least element according to comparator
+func NewPriorityQueue[T any](comparator coll.Comparator[T]) *PriorityQueue[T] {
+	if comparator == nil {
+		panic("Comparator can't be nil")
+	}
+	return &PriorityQueue[T]{comparator: comparator, generation: 0}
+}
+
+// Return new PriorityQueue[T] holding all elements of the collection. The heap is built in linear time
+func PriorityQueueFrom[T any](comparator coll.Comparator[T], elements coll.Collection[T]) *PriorityQueue[T] {
+	result := NewPriorityQueue(comparator)
+	result.heap = make([]*PriorityQueueHandle[T], 0, elements.Size())
+	elements.ForEach(func(i T) bool {
+		result.heap = append(result.heap, &PriorityQueueHandle[T]{value: i, index: len(result.heap)})
+		return true
+	})
+	result.heapify()
+	return result
+}
+
+func PriorityQueueOf[T any](comparator coll.Comparator[T], args ...T) *PriorityQueue[T] {
+	return PriorityQueueFrom[T](comparator, list.ArrayListOf(args...))
+}
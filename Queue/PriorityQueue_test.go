@@ -0,0 +1,71 @@
+package Queue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/gojava/common"
+)
+
+func intCompare(a, b int) int {
+	return a - b
+}
+
+func TestPriorityQueue(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	values := list.NewArrayList[int]()
+	for i := 0; i < 1000; i++ {
+		values.Add(rnd.Intn(500))
+	}
+	pq := PriorityQueueFrom[int](intCompare, values)
+	common.AssertEq(t, pq.Size(), 1000)
+
+	// Removing through the iterator must keep the heap valid, and still visit every element once
+	visited := 0
+	expected := []int{}
+	iter := pq.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		visited++
+		if next%3 == 0 {
+			iter.Remove()
+		} else {
+			expected = append(expected, next)
+		}
+	}
+	common.AssertEq(t, visited, 1000)
+	sort.Ints(expected)
+	common.AssertArrEq(t, drain(pq), expected)
+
+	pq = PriorityQueueOf(func(a, b int) int { return b - a }, 3, 1, 4, 1, 5, 9, 2, 6)
+	head, _ := pq.Peek()
+	common.AssertEq(t, head, 9)
+	common.AssertArrEq(t, drain(pq), []int{9, 6, 5, 4, 3, 2, 1, 1})
+}
+
+type vertex struct {
+	name     string
+	distance int
+}
+
+func TestPriorityQueueHandle(t *testing.T) {
+	pq := NewPriorityQueue(func(a, b vertex) int {
+		return a.distance - b.distance
+	})
+	handles := map[string]*PriorityQueueHandle[vertex]{}
+	for i, name := range []string{"a", "b", "c", "d"} {
+		handles[name] = pq.OfferHandle(vertex{name, 100 + i})
+	}
+	pq.Update(handles["d"], vertex{"d", 1})
+	pq.Update(handles["a"], vertex{"a", 500})
+	common.AssertTrue(t, pq.RemoveHandle(handles["b"]))
+	common.AssertFalse(t, handles["b"].InQueue())
+	common.AssertFalse(t, pq.RemoveHandle(handles["b"]))
+
+	order := []string{}
+	for next, ok := pq.Poll(); ok; next, ok = pq.Poll() {
+		order = append(order, next.name)
+	}
+	common.AssertArrEq(t, order, []string{"d", "c", "a"})
+}
@@ -0,0 +1,56 @@
+package Queue
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+)
+
+// A collection that holds elements before processing. Elements are added to the tail and read from the head
+type Queue[T any] interface {
+	coll.Collection[T]
+
+	// Adds element to the tail of the queue
+	// Returns false if the queue has no room for it
+	Offer(element T) (added bool)
+
+	// Removes and returns the head of the queue, if queue is empty, ok is set to false
+	Poll() (element T, ok bool)
+
+	// Returns the head of the queue without removing it, if queue is empty, ok is set to false
+	Peek() (element T, ok bool)
+}
+
+// A queue that supports adding and removing at both ends
+type Deque[T any] interface {
+	Queue[T]
+
+	// Adds element to the head
+	OfferFirst(element T) (added bool)
+
+	// Adds element to the tail
+	OfferLast(element T) (added bool)
+
+	// Removes and returns the head, if deque is empty, ok is set to false
+	PollFirst() (element T, ok bool)
+
+	// Removes and returns the tail, if deque is empty, ok is set to false
+	PollLast() (element T, ok bool)
+
+	// Returns the head without removing it
+	PeekFirst() (element T, ok bool)
+
+	// Returns the tail without removing it
+	PeekLast() (element T, ok bool)
+
+	// Pushes element to the head, so the deque can be used as a stack
+	Push(element T)
+
+	// Removes and returns the head, same as PollFirst
+	Pop() (element T, ok bool)
+
+	// Returns iterator from tail to head
+	DescendingIterator() coll.Iterator[T]
+}
+
+var _ Deque[int] = (*list.LinkedList[int])(nil)
+var _ Deque[int] = (*ArrayDeque[int])(nil)
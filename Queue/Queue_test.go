@@ -0,0 +1,89 @@
+package Queue
+
+import (
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/gojava/common"
+	"github.com/wushilin/stream"
+)
+
+func drain(q Queue[int]) []int {
+	result := []int{}
+	for next, ok := q.Poll(); ok; next, ok = q.Poll() {
+		result = append(result, next)
+	}
+	return result
+}
+
+func testDeque(t *testing.T, dq Deque[int]) {
+	stream.Range(0, 100).Each(func(i int) {
+		dq.Offer(i)
+	})
+	common.AssertEq(t, dq.Size(), 100)
+	head, _ := dq.Peek()
+	common.AssertEq(t, head, 0)
+	last, _ := dq.PeekLast()
+	common.AssertEq(t, last, 99)
+
+	dq.Push(-1)
+	dq.OfferFirst(-2)
+	first, _ := dq.PollFirst()
+	common.AssertEq(t, first, -2)
+	popped, _ := dq.Pop()
+	common.AssertEq(t, popped, -1)
+	polledLast, _ := dq.PollLast()
+	common.AssertEq(t, polledLast, 99)
+
+	iter := dq.Iterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if next%2 == 1 {
+			iter.Remove()
+		} else {
+			iter.Set(next * 10)
+		}
+	}
+	common.AssertEq(t, dq.Size(), 50)
+
+	descending := []int{}
+	coll.ForEach(dq.DescendingIterator(), func(i int) bool {
+		descending = append(descending, i)
+		return true
+	})
+	common.AssertEq(t, descending[0], 980)
+	common.AssertEq(t, descending[49], 0)
+
+	iter = dq.DescendingIterator()
+	for next, ok := iter.Next(); ok; next, ok = iter.Next() {
+		if next >= 100 {
+			iter.Remove()
+		}
+	}
+	common.AssertArrEq(t, drain(dq), []int{0, 20, 40, 60, 80})
+	_, ok := dq.Poll()
+	common.AssertFalse(t, ok)
+	_, ok = dq.Peek()
+	common.AssertFalse(t, ok)
+}
+
+func TestArrayDeque(t *testing.T) {
+	testDeque(t, NewArrayDeque[int]())
+
+	// Wrap around the ring buffer before it grows
+	dq := NewArrayDeque[int]()
+	for i := 0; i < 10; i++ {
+		dq.OfferLast(i)
+		dq.PollFirst()
+	}
+	for i := 0; i < 40; i++ {
+		dq.OfferFirst(i)
+	}
+	common.AssertEq(t, dq.Size(), 40)
+	common.AssertArrEq(t, dq.ToArray()[:3], []int{39, 38, 37})
+	coll.PrintCollection[int](dq)
+}
+
+func TestLinkedListDeque(t *testing.T) {
+	testDeque(t, list.NewLinkedList[int]())
+}
@@ -0,0 +1,119 @@
+package Set
+
+// Set algebra. The functions never modify their operands, they return new LinkedHashSets whose
+// iteration order follows the operands. Membership is tested with Contains() of the operands
+
+// PowerSet panics for sets larger than this, 2^20 subsets is already over a million
+const MaxPowerSetSize = 20
+
+// A pair of elements from CartesianProduct
+type Pair[A comparable, B comparable] struct {
+	First  A
+	Second B
+}
+
+// Elements that are in a or in b
+func Union[T comparable](a, b Set[T]) *LinkedHashSet[T] {
+	result := NewLinkedHashSet[T]()
+	result.AddAll(a)
+	result.AddAll(b)
+	return result
+}
+
+// Elements that are in both a and b. Iterates the smaller set, in its order
+func Intersection[T comparable](a, b Set[T]) *LinkedHashSet[T] {
+	if a.Size() > b.Size() {
+		a, b = b, a
+	}
+	result := NewLinkedHashSet[T]()
+	a.ForEach(func(i T) bool {
+		if b.Contains(i) {
+			result.Add(i)
+		}
+		return true
+	})
+	return result
+}
+
+// Elements of a that are not in b
+func Difference[T comparable](a, b Set[T]) *LinkedHashSet[T] {
+	result := NewLinkedHashSet[T]()
+	if b.IsEmpty() {
+		result.AddAll(a)
+		return result
+	}
+	a.ForEach(func(i T) bool {
+		if !b.Contains(i) {
+			result.Add(i)
+		}
+		return true
+	})
+	return result
+}
+
+// Elements that are in exactly one of a and b
+func SymmetricDifference[T comparable](a, b Set[T]) *LinkedHashSet[T] {
+	result := Difference(a, b)
+	b.ForEach(func(i T) bool {
+		if !a.Contains(i) {
+			result.Add(i)
+		}
+		return true
+	})
+	return result
+}
+
+// Whether every element of a is in b
+func IsSubsetOf[T comparable](a, b Set[T]) bool {
+	if a.Size() > b.Size() {
+		return false
+	}
+	subset := true
+	a.ForEach(func(i T) bool {
+		subset = b.Contains(i)
+		return subset
+	})
+	return subset
+}
+
+// Whether every element of b is in a
+func IsSupersetOf[T comparable](a, b Set[T]) bool {
+	return IsSubsetOf(b, a)
+}
+
+// Whether a and b have the same elements, regardless of their order or implementation
+func Equals[T comparable](a, b Set[T]) bool {
+	return a.Size() == b.Size() && IsSubsetOf(a, b)
+}
+
+// All pairs with the first element from a and the second from b
+func CartesianProduct[A comparable, B comparable](a Set[A], b Set[B]) *LinkedHashSet[Pair[A, B]] {
+	result := NewLinkedHashSet[Pair[A, B]]()
+	a.ForEach(func(first A) bool {
+		b.ForEach(func(second B) bool {
+			result.Add(Pair[A, B]{first, second})
+			return true
+		})
+		return true
+	})
+	return result
+}
+
+// All 2^n subsets of s, starting with the empty set. Panics if s has more than MaxPowerSetSize elements
+func PowerSet[T comparable](s Set[T]) []*LinkedHashSet[T] {
+	if s.Size() > MaxPowerSetSize {
+		panic("Set is too large for PowerSet")
+	}
+	elements := s.ToArray()
+	result := make([]*LinkedHashSet[T], 0, 1<<len(elements))
+	for mask := 0; mask < 1<<len(elements); mask++ {
+		subset := NewLinkedHashSet[T]()
+		for index, next := range elements {
+			if mask&(1<<index) != 0 {
+				subset.Add(next)
+			}
+		}
+		result = append(result, subset)
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package Set
+
+import (
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/common"
+)
+
+func TestAlgebra(t *testing.T) {
+	a := LinkedHashSetOf(1, 2, 3, 4)
+	b := TreeSetOf(coll.NaturalOrder[int](), 3, 4, 5)
+
+	common.AssertArrEq(t, Union[int](a, b).ToArray(), []int{1, 2, 3, 4, 5})
+	common.AssertArrEq(t, Intersection[int](a, b).ToArray(), []int{3, 4})
+	common.AssertArrEq(t, Difference[int](a, b).ToArray(), []int{1, 2})
+	common.AssertArrEq(t, SymmetricDifference[int](a, b).ToArray(), []int{1, 2, 5})
+	// Operands are not modified
+	common.AssertEq(t, a.Size(), 4)
+	common.AssertEq(t, b.Size(), 3)
+
+	common.AssertTrue(t, IsSubsetOf[int](HashSetOf(3, 4), b))
+	common.AssertFalse(t, IsSubsetOf[int](a, b))
+	common.AssertTrue(t, IsSupersetOf[int](a, SetOf(1, 4)))
+	common.AssertTrue(t, Equals[int](HashSetOf(1, 2, 3, 4), a))
+	common.AssertFalse(t, Equals[int](HashSetOf(1, 2, 3), a))
+
+	product := CartesianProduct[string, int](LinkedHashSetOf("x", "y"), LinkedHashSetOf(1, 2))
+	common.AssertArrEq(t, product.ToArray(), []Pair[string, int]{{"x", 1}, {"x", 2}, {"y", 1}, {"y", 2}})
+
+	subsets := PowerSet[int](b)
+	common.AssertEq(t, len(subsets), 8)
+	common.AssertTrue(t, subsets[0].IsEmpty())
+	common.AssertTrue(t, Equals[int](subsets[7], b))
+	large := NewHashSet[int]()
+	for i := 0; i <= MaxPowerSetSize; i++ {
+		large.Add(i)
+	}
+	func() {
+		defer func() {
+			common.AssertTrue(t, recover() != nil)
+		}()
+		PowerSet[int](large)
+		t.Fatal("Expect panic")
+	}()
+}
@@ -0,0 +1,266 @@
+package Set
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/stream"
+)
+
+// A hash set that uses the given Hasher and Equalizer instead of ==, so elements don't need to be comparable.
+// It can hold slices, structs with slice fields, or strings compared ignoring case.
+// Elements with the same hash share a bucket and are told apart by the Equalizer.
+// It is not a Set, because Set requires comparable elements
+type CustomHashSet[T any] struct {
+	buckets    map[uint64][]T
+	size       int
+	hasher     coll.Hasher[T]
+	equals     coll.Equalizer[T]
+	generation int
+}
+
+// Return an empty CustomHashSet. Values equal by equals must have the same hash by hasher.
+// A nil hasher or equals falls back to DefaultHasher and DefaultEqualizer, which use HashCode() and Equals() when available
+func NewCustomHashSet[T any](hasher coll.Hasher[T], equals coll.Equalizer[T]) *CustomHashSet[T] {
+	if hasher == nil {
+		hasher = coll.DefaultHasher[T]()
+	}
+	if equals == nil {
+		equals = coll.DefaultEqualizer[T]()
+	}
+	return &CustomHashSet[T]{buckets: make(map[uint64][]T), hasher: hasher, equals: equals}
+}
+
+// Return a CustomHashSet with the given elements, duplicates are dropped
+func CustomHashSetOf[T any](hasher coll.Hasher[T], equals coll.Equalizer[T], args ...T) *CustomHashSet[T] {
+	result := NewCustomHashSet(hasher, equals)
+	coll.AddElementsTo[T](result, args...)
+	return result
+}
+
+func (v *CustomHashSet[T]) applyMod() {
+	v.generation++
+}
+
+// Position of what in its bucket, -1 if not found
+func (v *CustomHashSet[T]) find(hash uint64, what T) int {
+	for index, next := range v.buckets[hash] {
+		if v.equals(next, what) {
+			return index
+		}
+	}
+	return -1
+}
+
+// Returns the Hasher of the set
+func (v *CustomHashSet[T]) Hasher() coll.Hasher[T] {
+	return v.hasher
+}
+
+// Returns the Equalizer of the set
+func (v *CustomHashSet[T]) Equalizer() coll.Equalizer[T] {
+	return v.equals
+}
+
+func (v *CustomHashSet[T]) Contains(what T) bool {
+	return v.find(v.hasher(what), what) != -1
+}
+
+// Test with equals instead of the Equalizer of the set. The hash can't be trusted for a
+// different Equalizer, so every element is checked
+func (v *CustomHashSet[T]) ContainsFunc(what T, equals coll.Equalizer[T]) bool {
+	found := false
+	v.ForEach(func(i T) bool {
+		found = equals(i, what)
+		return !found
+	})
+	return found
+}
+
+func (v *CustomHashSet[T]) ContainsAll(what coll.Collection[T]) bool {
+	containsAll := true
+	what.ForEach(func(i T) bool {
+		containsAll = v.Contains(i)
+		return containsAll
+	})
+	return containsAll
+}
+
+func (v *CustomHashSet[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *CustomHashSet[T]) Add(data T) bool {
+	hash := v.hasher(data)
+	if v.find(hash, data) != -1 {
+		return false
+	}
+	defer v.applyMod()
+	v.buckets[hash] = append(v.buckets[hash], data)
+	v.size++
+	return true
+}
+
+func (v *CustomHashSet[T]) AddAll(data coll.Collection[T]) int {
+	count := 0
+	data.ForEach(func(i T) bool {
+		if v.Add(i) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *CustomHashSet[T]) Remove(what T) bool {
+	hash := v.hasher(what)
+	index := v.find(hash, what)
+	if index == -1 {
+		return false
+	}
+	defer v.applyMod()
+	bucket := v.buckets[hash]
+	if len(bucket) == 1 {
+		delete(v.buckets, hash)
+	} else {
+		rest := make([]T, 0, len(bucket)-1)
+		rest = append(rest, bucket[:index]...)
+		v.buckets[hash] = append(rest, bucket[index+1:]...)
+	}
+	v.size--
+	return true
+}
+
+func (v *CustomHashSet[T]) RemoveAll(what coll.Collection[T]) int {
+	count := 0
+	what.ForEach(func(i T) bool {
+		if v.Remove(i) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+// Remove the elements equal by equals to any element of what. Every pair is compared
+func (v *CustomHashSet[T]) RemoveAllFunc(what coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.removeIf(func(i T) bool {
+		return containsFunc(what, i, equals)
+	})
+}
+
+func (v *CustomHashSet[T]) RetainAll(what coll.Collection[T]) int {
+	other := NewCustomHashSet(v.hasher, v.equals)
+	other.AddAll(what)
+	return v.removeIf(func(i T) bool {
+		return !other.Contains(i)
+	})
+}
+
+// Retain the elements equal by equals to some element of what. Every pair is compared
+func (v *CustomHashSet[T]) RetainAllFunc(what coll.Collection[T], equals coll.Equalizer[T]) int {
+	return v.removeIf(func(i T) bool {
+		return !containsFunc(what, i, equals)
+	})
+}
+
+func (v *CustomHashSet[T]) removeIf(test func(T) bool) int {
+	iter := v.Iterator()
+	count := 0
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if test(item) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
+}
+
+// Scans what for data, without relying on what.ContainsFunc honoring equals
+func containsFunc[T any](what coll.Collection[T], data T, equals coll.Equalizer[T]) bool {
+	found := false
+	what.ForEach(func(i T) bool {
+		found = equals(i, data)
+		return !found
+	})
+	return found
+}
+
+func (v *CustomHashSet[T]) Clear() int {
+	defer v.applyMod()
+	old := v.size
+	v.buckets = make(map[uint64][]T)
+	v.size = 0
+	return old
+}
+
+func (v *CustomHashSet[T]) IsEmpty() bool {
+	return v.size == 0
+}
+
+func (v *CustomHashSet[T]) Size() int {
+	return v.size
+}
+
+func (v *CustomHashSet[T]) ToArray() []T {
+	result := make([]T, 0, v.size)
+	for _, bucket := range v.buckets {
+		result = append(result, bucket...)
+	}
+	return result
+}
+
+func (v *CustomHashSet[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+// Iterates a snapshot of the elements. Remove() and Set() change the set
+func (v *CustomHashSet[T]) Iterator() coll.Iterator[T] {
+	return &CustomHashSetIterator[T]{Src: v, generation: v.generation, lastIndex: -1, keys: v.ToArray()}
+}
+
+type CustomHashSetIterator[T any] struct {
+	Src          *CustomHashSet[T]
+	generation   int
+	currentIndex int
+	lastIndex    int
+	keys         []T
+}
+
+func (v *CustomHashSetIterator[T]) checkMod() {
+	if v.generation != v.Src.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *CustomHashSetIterator[T]) Next() (result T, ok bool) {
+	v.checkMod()
+	if v.currentIndex >= len(v.keys) {
+		return result, false
+	}
+	result = v.keys[v.currentIndex]
+	v.currentIndex++
+	v.lastIndex = v.currentIndex - 1
+	return result, true
+}
+
+func (v *CustomHashSetIterator[T]) Remove() {
+	v.checkMod()
+	if v.lastIndex == -1 {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	v.Src.Remove(v.keys[v.lastIndex])
+	v.lastIndex = -1
+	v.generation = v.Src.generation
+}
+
+func (v *CustomHashSetIterator[T]) Set(data T) T {
+	v.checkMod()
+	if v.lastIndex == -1 {
+		panic("Don't call set before reading")
+	}
+	lastKey := v.keys[v.lastIndex]
+	v.Src.Remove(lastKey)
+	v.Src.Add(data)
+	v.keys[v.lastIndex] = data
+	v.generation = v.Src.generation
+	return lastKey
+}
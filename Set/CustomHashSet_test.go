@@ -0,0 +1,127 @@
+package Set
+
+import (
+	"strings"
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/common"
+)
+
+type tagged struct {
+	Name string
+	Tags []string
+}
+
+func TestCustomHashSet(t *testing.T) {
+	slices := NewCustomHashSet(coll.DefaultHasher[[]int](), coll.DefaultEqualizer[[]int]())
+	common.AssertTrue(t, slices.Add([]int{1, 2}))
+	common.AssertFalse(t, slices.Add([]int{1, 2}))
+	common.AssertTrue(t, slices.Add([]int{2, 1}))
+	common.AssertTrue(t, slices.Contains([]int{2, 1}))
+	common.AssertEq(t, slices.Size(), 2)
+	common.AssertTrue(t, slices.Remove([]int{1, 2}))
+	common.AssertFalse(t, slices.Contains([]int{1, 2}))
+
+	structs := CustomHashSetOf(coll.DefaultHasher[tagged](), coll.DefaultEqualizer[tagged](),
+		tagged{"a", []string{"x"}}, tagged{"a", []string{"x"}}, tagged{"a", []string{"y"}})
+	common.AssertEq(t, structs.Size(), 2)
+
+	lower := strings.ToLower
+	names := CustomHashSetOf(coll.HashingBy(lower), coll.EqualizingBy(lower), "Alice", "ALICE", "Bob")
+	common.AssertEq(t, names.Size(), 2)
+	common.AssertTrue(t, names.Contains("alice"))
+	// The Func methods use the given equalizer, not the one of the set
+	common.AssertFalse(t, names.ContainsFunc("alice", coll.DefaultEqualizer[string]()))
+	common.AssertTrue(t, names.ContainsFunc("bob", strings.EqualFold))
+	common.AssertEq(t, names.RemoveAllFunc(HashSetOf("bob"), coll.DefaultEqualizer[string]()), 0)
+	common.AssertEq(t, names.RemoveAllFunc(HashSetOf("bob"), strings.EqualFold), 1)
+	common.AssertEq(t, names.RetainAll(HashSetOf("alice", "carol")), 0)
+	common.AssertEq(t, names.Size(), 1)
+
+	iter := names.Iterator()
+	iter.Next()
+	iter.Set("Dave")
+	common.AssertTrue(t, names.Contains("DAVE"))
+	iter.Remove()
+	common.AssertTrue(t, names.IsEmpty())
+}
+
+func TestCustomHashSetCollisions(t *testing.T) {
+	// Everything lands in the same bucket
+	s := NewCustomHashSet(func(int) uint64 { return 1 }, coll.DefaultEqualizer[int]())
+	for i := 0; i < 100; i++ {
+		s.Add(i)
+	}
+	common.AssertEq(t, s.Size(), 100)
+	for i := 0; i < 100; i += 2 {
+		common.AssertTrue(t, s.Remove(i))
+	}
+	common.AssertEq(t, s.Size(), 50)
+	common.AssertTrue(t, s.Contains(99))
+	common.AssertFalse(t, s.Contains(98))
+}
+
+func TestDefaultHasher(t *testing.T) {
+	hasher := coll.DefaultHasher[any]()
+	common.AssertEq(t, hasher(map[string][]int{"a": {1}, "b": {2}}), hasher(map[string][]int{"b": {2}, "a": {1}}))
+	one, other := 1, 1
+	common.AssertEq(t, hasher(&one), hasher(&other))
+	common.AssertEq(t, hasher(0.0), hasher(-0.0*1))
+	common.AssertTrue(t, hasher([]int{1, 2}) != hasher([]int{2, 1}))
+}
+
+// Equal by id only, the cache must not matter
+type cached struct {
+	id    int
+	cache []string
+}
+
+func (v *cached) Equals(other *cached) bool {
+	return other != nil && v.id == other.id
+}
+
+func (v *cached) HashCode() uint64 {
+	return uint64(v.id)
+}
+
+func TestEquatableAndHashable(t *testing.T) {
+	a := &cached{id: 1, cache: []string{"x"}}
+	b := &cached{id: 1}
+	equals := coll.DefaultEqualizer[*cached]()
+	hasher := coll.DefaultHasher[*cached]()
+	common.AssertTrue(t, equals(a, b))
+	common.AssertFalse(t, equals(a, &cached{id: 2}))
+	common.AssertEq(t, hasher(a), hasher(b))
+	// nil values don't call the methods
+	common.AssertTrue(t, equals(nil, nil))
+	common.AssertFalse(t, equals(nil, a))
+	common.AssertEq(t, hasher(nil), hasher(nil))
+
+	s := CustomHashSetOf[*cached](nil, nil, a, b, &cached{id: 2})
+	common.AssertEq(t, s.Size(), 2)
+	common.AssertTrue(t, s.Contains(&cached{id: 2, cache: []string{"y"}}))
+}
+
+// Equal by id only, without a HashCode()
+type unhashed struct {
+	id    int
+	cache []string
+}
+
+func (v *unhashed) Equals(other *unhashed) bool {
+	return other != nil && v.id == other.id
+}
+
+func TestEquatableWithoutHashable(t *testing.T) {
+	a := &unhashed{id: 1, cache: []string{"x"}}
+	b := &unhashed{id: 1}
+	hasher := coll.DefaultHasher[*unhashed]()
+	common.AssertEq(t, hasher(a), hasher(b))
+
+	s := CustomHashSetOf[*unhashed](nil, nil, a, b, &unhashed{id: 2})
+	common.AssertEq(t, s.Size(), 2)
+	common.AssertTrue(t, s.Contains(&unhashed{id: 1, cache: []string{"y"}}))
+	common.AssertTrue(t, s.Remove(b))
+	common.AssertEq(t, s.Size(), 1)
+}
@@ -5,10 +5,6 @@ import (
 	"github.com/wushilin/stream"
 )
 
-type Set[T comparable] interface {
-	coll.Collection[T]
-}
-
 type HashSet[T comparable] struct {
 	data       map[T]any
 	generation int
@@ -19,8 +15,14 @@ func (v *HashSet[T]) Contains(what T) bool {
 	return ok
 }
 
+// Scans the elements with equals, Contains() is the O(1) lookup
 func (v *HashSet[T]) ContainsFunc(what T, equals coll.Equalizer[T]) bool {
-	return v.Contains(what)
+	for next := range v.data {
+		if equals(next, what) {
+			return true
+		}
+	}
+	return false
 }
 
 func (v *HashSet[T]) ForEach(visitor coll.Visitor[T]) int {
@@ -102,11 +104,27 @@ func (v *HashSet[T]) RemoveAll(what coll.Collection[T]) int {
 }
 
 func (v *HashSet[T]) RemoveAllFunc(what coll.Collection[T], equals coll.Equalizer[T]) int {
-	return v.RemoveAll(what)
+	iter := v.Iterator()
+	count := 0
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if containsFunc(what, item, equals) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
 }
 
 func (v *HashSet[T]) RetainAllFunc(what coll.Collection[T], equals coll.Equalizer[T]) int {
-	return v.RetainAll(what)
+	iter := v.Iterator()
+	count := 0
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if !containsFunc(what, item, equals) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
 }
 
 func (v *HashSet[T]) RetainAll(what coll.Collection[T]) int {
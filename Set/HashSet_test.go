@@ -75,3 +75,16 @@ func TestHashset(t *testing.T) {
 func print(i int) {
 	fmt.Println(i)
 }
+
+func TestHashSetFunc(t *testing.T) {
+	sameParity := func(a, b int) bool {
+		return a%2 == b%2
+	}
+	set := HashSetOf(1, 2, 3, 4, 5)
+	common.AssertTrue(t, set.ContainsFunc(7, sameParity))
+	common.AssertFalse(t, set.ContainsFunc(7, coll.DefaultEqualizer[int]()))
+	common.AssertEq(t, set.RemoveAllFunc(HashSetOf(9), sameParity), 3)
+	common.AssertEq(t, set.Size(), 2)
+	common.AssertTrue(t, set.Contains(2) && set.Contains(4))
+	common.AssertEq(t, HashSetOf(1, 2, 3).RetainAllFunc(HashSetOf(8), sameParity), 2)
+}
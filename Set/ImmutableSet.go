@@ -0,0 +1,193 @@
+package Set
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/stream"
+)
+
+// Sets up to this size are searched linearly, without a hash index
+const immutableSetLinearSize = 8
+
+func immutable() {
+	panic("Unsupported operation: collection is immutable")
+}
+
+// A Set that can't be modified after it is created. Methods that modify it panic, including
+// Remove() and Set() of its iterators. It is safe to share across goroutines.
+// Elements are kept in an array in the order they were given, small sets don't allocate a hash index
+type ImmutableSet[T comparable] struct {
+	data  []T
+	index map[T]int
+}
+
+// Return an ImmutableSet with the given elements. Panics on duplicate elements
+func SetOf[T comparable](args ...T) *ImmutableSet[T] {
+	result := newImmutableSet(args)
+	if len(result.data) != len(args) {
+		panic("Duplicate element")
+	}
+	return result
+}
+
+// Build the set, dropping duplicates
+func newImmutableSet[T comparable](args []T) *ImmutableSet[T] {
+	result := &ImmutableSet[T]{}
+	if len(args) > immutableSetLinearSize {
+		result.index = make(map[T]int, len(args))
+	}
+	for _, next := range args {
+		if result.IndexOf(next) != -1 {
+			continue
+		}
+		if result.index != nil {
+			result.index[next] = len(result.data)
+		}
+		result.data = append(result.data, next)
+	}
+	return result
+}
+
+// Returns the position of element in iteration order, -1 if not found
+func (v *ImmutableSet[T]) IndexOf(element T) int {
+	if v.index != nil {
+		if index, ok := v.index[element]; ok {
+			return index
+		}
+		return -1
+	}
+	for index, next := range v.data {
+		if next == element {
+			return index
+		}
+	}
+	return -1
+}
+
+// Returns the element at position index in iteration order
+func (v *ImmutableSet[T]) Get(index int) T {
+	if index < 0 || index >= len(v.data) {
+		panic("Index Out of Bound")
+	}
+	return v.data[index]
+}
+
+func (v *ImmutableSet[T]) Size() int {
+	return len(v.data)
+}
+
+func (v *ImmutableSet[T]) IsEmpty() bool {
+	return len(v.data) == 0
+}
+
+func (v *ImmutableSet[T]) Contains(what T) bool {
+	return v.IndexOf(what) != -1
+}
+
+func (v *ImmutableSet[T]) ContainsFunc(what T, equals coll.Equalizer[T]) bool {
+	for _, next := range v.data {
+		if equals(next, what) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *ImmutableSet[T]) Add(data T) bool {
+	immutable()
+	return false
+}
+
+func (v *ImmutableSet[T]) AddAll(data coll.Collection[T]) int {
+	immutable()
+	return 0
+}
+
+func (v *ImmutableSet[T]) RemoveAll(collection coll.Collection[T]) int {
+	immutable()
+	return 0
+}
+
+func (v *ImmutableSet[T]) RemoveAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	immutable()
+	return 0
+}
+
+func (v *ImmutableSet[T]) RetainAll(collection coll.Collection[T]) int {
+	immutable()
+	return 0
+}
+
+func (v *ImmutableSet[T]) RetainAllFunc(collection coll.Collection[T], equals coll.Equalizer[T]) int {
+	immutable()
+	return 0
+}
+
+func (v *ImmutableSet[T]) Clear() int {
+	immutable()
+	return 0
+}
+
+func (v *ImmutableSet[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *ImmutableSet[T]) ToArray() []T {
+	result := make([]T, len(v.data))
+	copy(result, v.data)
+	return result
+}
+
+func (v *ImmutableSet[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *ImmutableSet[T]) Iterator() coll.Iterator[T] {
+	return &ImmutableSetIterator[T]{data: v.data}
+}
+
+type ImmutableSetIterator[T comparable] struct {
+	data         []T
+	currentIndex int
+}
+
+func (v *ImmutableSetIterator[T]) Next() (result T, ok bool) {
+	if v.currentIndex >= len(v.data) {
+		return result, false
+	}
+	result = v.data[v.currentIndex]
+	v.currentIndex++
+	return result, true
+}
+
+func (v *ImmutableSetIterator[T]) Remove() {
+	immutable()
+}
+
+func (v *ImmutableSetIterator[T]) Set(data T) T {
+	immutable()
+	return data
+}
+
+// Collects elements for an ImmutableSet. Duplicates are ignored
+type ImmutableSetBuilder[T comparable] struct {
+	data []T
+}
+
+func NewImmutableSetBuilder[T comparable]() *ImmutableSetBuilder[T] {
+	return &ImmutableSetBuilder[T]{}
+}
+
+func (v *ImmutableSetBuilder[T]) Add(elements ...T) *ImmutableSetBuilder[T] {
+	v.data = append(v.data, elements...)
+	return v
+}
+
+func (v *ImmutableSetBuilder[T]) AddAll(elements coll.Collection[T]) *ImmutableSetBuilder[T] {
+	return v.Add(elements.ToArray()...)
+}
+
+// Returns an ImmutableSet with the elements added so far, in the order they were first added.
+// The builder can still be used afterwards
+func (v *ImmutableSetBuilder[T]) Build() *ImmutableSet[T] {
+	return newImmutableSet(v.data)
+}
@@ -0,0 +1,34 @@
+package Set
+
+import (
+	"testing"
+
+	"github.com/wushilin/gojava/common"
+)
+
+func TestImmutableSet(t *testing.T) {
+	small := SetOf("b", "a", "c")
+	common.AssertTrue(t, small.Contains("a"))
+	common.AssertFalse(t, small.Contains("d"))
+	common.AssertArrEq(t, small.ToArray(), []string{"b", "a", "c"})
+
+	builder := NewImmutableSetBuilder[int]()
+	for i := 0; i < 100; i++ {
+		builder.Add(i % 50)
+	}
+	large := builder.Build()
+	common.AssertEq(t, large.Size(), 50)
+	common.AssertEq(t, large.IndexOf(49), 49)
+	common.AssertEq(t, large.IndexOf(50), -1)
+
+	panics := func(f func()) (panicked bool) {
+		defer func() {
+			panicked = recover() != nil
+		}()
+		f()
+		return
+	}
+	common.AssertTrue(t, panics(func() { SetOf(1, 2, 1) }))
+	common.AssertTrue(t, panics(func() { small.Add("d") }))
+	common.AssertTrue(t, panics(func() { large.Iterator().Remove() }))
+}
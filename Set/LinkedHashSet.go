@@ -0,0 +1,199 @@
+package Set
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/stream"
+)
+
+type linkedHashSetNode[T comparable] struct {
+	data T
+	prev *linkedHashSetNode[T]
+	next *linkedHashSetNode[T]
+}
+
+// A hash set that iterates in insertion order. Adding an element that is already present keeps its position
+type LinkedHashSet[T comparable] struct {
+	data       map[T]*linkedHashSetNode[T]
+	head       *linkedHashSetNode[T]
+	tail       *linkedHashSetNode[T]
+	generation int
+}
+
+func (v *LinkedHashSet[T]) applyMod() {
+	v.generation++
+}
+
+func (v *LinkedHashSet[T]) linkLast(node *linkedHashSetNode[T]) {
+	node.prev = v.tail
+	node.next = nil
+	if v.tail == nil {
+		v.head = node
+	} else {
+		v.tail.next = node
+	}
+	v.tail = node
+}
+
+func (v *LinkedHashSet[T]) removeNode(node *linkedHashSetNode[T]) {
+	if node.prev == nil {
+		v.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		v.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.prev = nil
+	node.next = nil
+	delete(v.data, node.data)
+}
+
+func (v *LinkedHashSet[T]) Contains(what T) bool {
+	_, ok := v.data[what]
+	return ok
+}
+
+func (v *LinkedHashSet[T]) ContainsFunc(what T, equals coll.Equalizer[T]) bool {
+	for node := v.head; node != nil; node = node.next {
+		if equals(node.data, what) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *LinkedHashSet[T]) ContainsAll(what coll.Collection[T]) bool {
+	containsAll := true
+	what.ForEach(func(i T) bool {
+		if !v.Contains(i) {
+			containsAll = false
+			return false
+		}
+		return true
+	})
+	return containsAll
+}
+
+func (v *LinkedHashSet[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+func (v *LinkedHashSet[T]) Add(data T) bool {
+	if v.Contains(data) {
+		return false
+	}
+	defer v.applyMod()
+	node := &linkedHashSetNode[T]{data: data}
+	v.data[data] = node
+	v.linkLast(node)
+	return true
+}
+
+func (v *LinkedHashSet[T]) AddAll(data coll.Collection[T]) int {
+	count := 0
+	data.ForEach(func(i T) bool {
+		if v.Add(i) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *LinkedHashSet[T]) Clear() int {
+	defer v.applyMod()
+	old := v.Size()
+	v.data = make(map[T]*linkedHashSetNode[T])
+	v.head = nil
+	v.tail = nil
+	return old
+}
+
+func (v *LinkedHashSet[T]) IsEmpty() bool {
+	return v.Size() == 0
+}
+
+func (v *LinkedHashSet[T]) Size() int {
+	return len(v.data)
+}
+
+func (v *LinkedHashSet[T]) Iterator() coll.Iterator[T] {
+	return NewLinkedHashSetIteratorFor(v)
+}
+
+func (v *LinkedHashSet[T]) Remove(what T) bool {
+	node, ok := v.data[what]
+	if !ok {
+		return false
+	}
+	defer v.applyMod()
+	v.removeNode(node)
+	return true
+}
+
+func (v *LinkedHashSet[T]) RemoveAll(what coll.Collection[T]) int {
+	count := 0
+	what.ForEach(func(key T) bool {
+		if v.Remove(key) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *LinkedHashSet[T]) RemoveAllFunc(what coll.Collection[T], equals coll.Equalizer[T]) int {
+	iter := v.Iterator()
+	count := 0
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if what.ContainsFunc(item, equals) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
+}
+
+func (v *LinkedHashSet[T]) RetainAll(what coll.Collection[T]) int {
+	iter := v.Iterator()
+	count := 0
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if !what.Contains(item) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
+}
+
+func (v *LinkedHashSet[T]) RetainAllFunc(what coll.Collection[T], equals coll.Equalizer[T]) int {
+	iter := v.Iterator()
+	count := 0
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if !what.ContainsFunc(item, equals) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
+}
+
+func (v *LinkedHashSet[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *LinkedHashSet[T]) ToArray() []T {
+	return coll.ToArray(v.Size(), v.Iterator())
+}
+
+func NewLinkedHashSet[T comparable]() *LinkedHashSet[T] {
+	return &LinkedHashSet[T]{data: make(map[T]*linkedHashSetNode[T]), generation: 0}
+}
+
+func LinkedHashSetOf[T comparable](args ...T) *LinkedHashSet[T] {
+	result := NewLinkedHashSet[T]()
+	coll.AddElementsTo[T](result, args...)
+	return result
+}
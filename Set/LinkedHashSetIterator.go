@@ -0,0 +1,68 @@
+package Set
+
+import coll "github.com/wushilin/gojava/Collection"
+
+type LinkedHashSetIterator[T comparable] struct {
+	Src          *LinkedHashSet[T]
+	generation   int
+	next         *linkedHashSetNode[T]
+	lastReturned *linkedHashSetNode[T]
+}
+
+func (v *LinkedHashSetIterator[T]) applyMod() {
+	v.generation++
+	v.Src.generation = v.generation
+}
+
+func (v *LinkedHashSetIterator[T]) checkMod() {
+	if v.generation != v.Src.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *LinkedHashSetIterator[T]) Next() (result T, ok bool) {
+	v.checkMod()
+	if v.next == nil {
+		return result, false
+	}
+	v.lastReturned = v.next
+	v.next = v.next.next
+	return v.lastReturned.data, true
+}
+
+func (v *LinkedHashSetIterator[T]) Remove() {
+	v.checkMod()
+	defer v.applyMod()
+	if v.lastReturned == nil {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	v.Src.removeNode(v.lastReturned)
+	v.lastReturned = nil
+}
+
+// Replaces the current element in place. If the new element is already in the set elsewhere,
+// the current element is just removed
+func (v *LinkedHashSetIterator[T]) Set(data T) T {
+	v.checkMod()
+	defer v.applyMod()
+	if v.lastReturned == nil {
+		panic("Don't call set before reading")
+	}
+	old := v.lastReturned.data
+	if old == data {
+		return old
+	}
+	if v.Src.Contains(data) {
+		v.Src.removeNode(v.lastReturned)
+		v.lastReturned = nil
+		return old
+	}
+	delete(v.Src.data, old)
+	v.lastReturned.data = data
+	v.Src.data[data] = v.lastReturned
+	return old
+}
+
+func NewLinkedHashSetIteratorFor[T comparable](v *LinkedHashSet[T]) coll.Iterator[T] {
+	return &LinkedHashSetIterator[T]{Src: v, generation: v.generation, next: v.head}
+}
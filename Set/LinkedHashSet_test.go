@@ -0,0 +1,39 @@
+package Set
+
+import (
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/common"
+	"github.com/wushilin/stream"
+)
+
+func TestLinkedHashSet(t *testing.T) {
+	set := LinkedHashSetOf(5, 3, 9, 1, 3, 7)
+	coll.PrintCollection[int](set)
+	common.AssertEq(t, set.Size(), 5)
+	common.AssertArrEq(t, set.ToArray(), []int{5, 3, 9, 1, 7})
+	common.AssertFalse(t, set.Add(9))
+	common.AssertArrEq(t, stream.CollectAll(set.Stream().Iterator()), []int{5, 3, 9, 1, 7})
+
+	set.Remove(3)
+	set.Add(3)
+	common.AssertArrEq(t, set.ToArray(), []int{5, 9, 1, 7, 3})
+
+	iter := set.Iterator()
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if item == 9 {
+			iter.Remove()
+		} else if item == 1 {
+			iter.Set(100)
+		}
+	}
+	common.AssertArrEq(t, set.ToArray(), []int{5, 100, 7, 3})
+
+	set.RetainAll(HashSetOf(100, 3, 42))
+	common.AssertArrEq(t, set.ToArray(), []int{100, 3})
+	set.RemoveAllFunc(LinkedHashSetOf(4), func(i, j int) bool {
+		return i%2 == j%2
+	})
+	common.AssertArrEq(t, set.ToArray(), []int{3})
+}
@@ -5,3 +5,50 @@ import coll "github.com/wushilin/gojava/Collection"
 type Set[T comparable] interface {
 	coll.Collection[T]
 }
+
+// A Set that keeps its elements ordered by a comparator.
+// Iterator(), ToArray() and Stream() follow the element order.
+type SortedSet[T comparable] interface {
+	Set[T]
+
+	// Return the lowest element, if set is empty, ok is set to false
+	First() (element T, ok bool)
+
+	// Return the highest element, if set is empty, ok is set to false
+	Last() (element T, ok bool)
+}
+
+// A SortedSet that supports navigation and live range views
+type NavigableSet[T comparable] interface {
+	SortedSet[T]
+
+	// Return the greatest element less than or equal to the given element
+	Floor(what T) (element T, ok bool)
+
+	// Return the least element greater than or equal to the given element
+	Ceiling(what T) (element T, ok bool)
+
+	// Return the greatest element strictly less than the given element
+	Lower(what T) (element T, ok bool)
+
+	// Return the least element strictly greater than the given element
+	Higher(what T) (element T, ok bool)
+
+	// Remove and return the lowest element, if set is empty, ok is set to false
+	PollFirst() (element T, ok bool)
+
+	// Remove and return the highest element, if set is empty, ok is set to false
+	PollLast() (element T, ok bool)
+
+	// Return a live view of the elements less than (or equal to, if inclusive) to
+	HeadSet(to T, inclusive bool) NavigableSet[T]
+
+	// Return a live view of the elements greater than (or equal to, if inclusive) from
+	TailSet(from T, inclusive bool) NavigableSet[T]
+
+	// Return a live view of the elements ranging from from to to
+	SubSet(from T, fromInclusive bool, to T, toInclusive bool) NavigableSet[T]
+
+	// Return a live view of this set in reverse order
+	DescendingSet() NavigableSet[T]
+}
@@ -0,0 +1,243 @@
+package Set
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/internal/rbtree"
+	"github.com/wushilin/stream"
+)
+
+type treeSetData[T comparable] struct {
+	tree       *rbtree.Tree[T, struct{}]
+	generation int
+}
+
+// A red-black tree based set. Elements are ordered by the comparator given at creation time.
+// Range views returned by HeadSet, TailSet, SubSet and DescendingSet are TreeSets sharing the same data.
+type TreeSet[T comparable] struct {
+	data   *treeSetData[T]
+	bounds rbtree.Range[T, struct{}]
+}
+
+func (v *TreeSet[T]) applyMod() {
+	v.data.generation++
+}
+
+func (v *TreeSet[T]) Size() int {
+	return v.bounds.Size()
+}
+
+func (v *TreeSet[T]) IsEmpty() bool {
+	return v.bounds.First() == nil
+}
+
+func (v *TreeSet[T]) Contains(what T) bool {
+	return v.bounds.InRange(what) && v.data.tree.Get(what) != nil
+}
+
+// Tests equality with equals instead of the comparator. It iterates the elements
+func (v *TreeSet[T]) ContainsFunc(what T, equals coll.Equalizer[T]) bool {
+	for node := v.bounds.First(); node != nil; node = v.bounds.Next(node) {
+		if equals(node.Key, what) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *TreeSet[T]) ContainsAll(what coll.Collection[T]) bool {
+	containsAll := true
+	what.ForEach(func(i T) bool {
+		if !v.Contains(i) {
+			containsAll = false
+			return false
+		}
+		return true
+	})
+	return containsAll
+}
+
+func (v *TreeSet[T]) ForEach(visitor coll.Visitor[T]) int {
+	return coll.ForEach(v.Iterator(), visitor)
+}
+
+// Adding an element out of the range of a view panics
+func (v *TreeSet[T]) Add(data T) bool {
+	v.bounds.RangeCheck(data)
+	if v.data.tree.Get(data) != nil {
+		return false
+	}
+	defer v.applyMod()
+	v.data.tree.Put(data, struct{}{})
+	return true
+}
+
+func (v *TreeSet[T]) AddAll(data coll.Collection[T]) int {
+	count := 0
+	data.ForEach(func(i T) bool {
+		if v.Add(i) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *TreeSet[T]) Remove(what T) bool {
+	if !v.bounds.InRange(what) {
+		return false
+	}
+	node := v.data.tree.Get(what)
+	if node == nil {
+		return false
+	}
+	defer v.applyMod()
+	v.data.tree.Delete(node)
+	return true
+}
+
+func (v *TreeSet[T]) RemoveAll(what coll.Collection[T]) int {
+	count := 0
+	what.ForEach(func(key T) bool {
+		if v.Remove(key) {
+			count++
+		}
+		return true
+	})
+	return count
+}
+
+func (v *TreeSet[T]) RemoveAllFunc(what coll.Collection[T], equals coll.Equalizer[T]) int {
+	iter := v.Iterator()
+	count := 0
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if what.ContainsFunc(item, equals) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
+}
+
+func (v *TreeSet[T]) RetainAll(what coll.Collection[T]) int {
+	iter := v.Iterator()
+	count := 0
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if !what.Contains(item) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
+}
+
+func (v *TreeSet[T]) RetainAllFunc(what coll.Collection[T], equals coll.Equalizer[T]) int {
+	iter := v.Iterator()
+	count := 0
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if !what.ContainsFunc(item, equals) {
+			iter.Remove()
+			count++
+		}
+	}
+	return count
+}
+
+// Clearing a view removes its elements from the backing set
+func (v *TreeSet[T]) Clear() int {
+	iter := v.Iterator()
+	count := 0
+	for _, ok := iter.Next(); ok; _, ok = iter.Next() {
+		iter.Remove()
+		count++
+	}
+	return count
+}
+
+func (v *TreeSet[T]) Iterator() coll.Iterator[T] {
+	return NewTreeSetIteratorFor(v)
+}
+
+func (v *TreeSet[T]) Stream() stream.Stream[T] {
+	return stream.FromIterator[T](v.Iterator())
+}
+
+func (v *TreeSet[T]) ToArray() []T {
+	return coll.ToArray(v.Size(), v.Iterator())
+}
+
+func (v *TreeSet[T]) First() (T, bool) {
+	return elementOf(v.bounds.First())
+}
+
+func (v *TreeSet[T]) Last() (T, bool) {
+	return elementOf(v.bounds.Last())
+}
+
+func (v *TreeSet[T]) Floor(what T) (T, bool) {
+	return elementOf(v.bounds.Floor(what))
+}
+
+func (v *TreeSet[T]) Ceiling(what T) (T, bool) {
+	return elementOf(v.bounds.Ceiling(what))
+}
+
+func (v *TreeSet[T]) Lower(what T) (T, bool) {
+	return elementOf(v.bounds.Lower(what))
+}
+
+func (v *TreeSet[T]) Higher(what T) (T, bool) {
+	return elementOf(v.bounds.Higher(what))
+}
+
+func (v *TreeSet[T]) PollFirst() (T, bool) {
+	return v.poll(v.bounds.First())
+}
+
+func (v *TreeSet[T]) PollLast() (T, bool) {
+	return v.poll(v.bounds.Last())
+}
+
+func (v *TreeSet[T]) poll(node *rbtree.Node[T, struct{}]) (result T, ok bool) {
+	if node == nil {
+		return
+	}
+	defer v.applyMod()
+	result = node.Key
+	v.data.tree.Delete(node)
+	return result, true
+}
+
+func (v *TreeSet[T]) HeadSet(to T, inclusive bool) NavigableSet[T] {
+	return &TreeSet[T]{data: v.data, bounds: v.bounds.Head(to, inclusive)}
+}
+
+func (v *TreeSet[T]) TailSet(from T, inclusive bool) NavigableSet[T] {
+	return &TreeSet[T]{data: v.data, bounds: v.bounds.Tail(from, inclusive)}
+}
+
+func (v *TreeSet[T]) SubSet(from T, fromInclusive bool, to T, toInclusive bool) NavigableSet[T] {
+	return &TreeSet[T]{data: v.data, bounds: v.bounds.Sub(from, fromInclusive, to, toInclusive)}
+}
+
+func (v *TreeSet[T]) DescendingSet() NavigableSet[T] {
+	return &TreeSet[T]{data: v.data, bounds: v.bounds.Reversed()}
+}
+
+func elementOf[T comparable](node *rbtree.Node[T, struct{}]) (result T, ok bool) {
+	if node == nil {
+		return
+	}
+	return node.Key, true
+}
+
+// Return new empty TreeSet[T], elements are ordered by comparator
+func NewTreeSet[T comparable](comparator coll.Comparator[T]) *TreeSet[T] {
+	tree := rbtree.New[T, struct{}](comparator)
+	return &TreeSet[T]{data: &treeSetData[T]{tree: tree, generation: 0}, bounds: tree.All()}
+}
+
+func TreeSetOf[T comparable](comparator coll.Comparator[T], args ...T) *TreeSet[T] {
+	result := NewTreeSet(comparator)
+	coll.AddElementsTo[T](result, args...)
+	return result
+}
@@ -0,0 +1,70 @@
+package Set
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/internal/rbtree"
+)
+
+type TreeSetIterator[T comparable] struct {
+	Src          *TreeSet[T]
+	generation   int
+	next         *rbtree.Node[T, struct{}]
+	lastReturned *rbtree.Node[T, struct{}]
+}
+
+func (v *TreeSetIterator[T]) applyMod() {
+	v.generation++
+	v.Src.data.generation = v.generation
+}
+
+func (v *TreeSetIterator[T]) checkMod() {
+	if v.generation != v.Src.data.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *TreeSetIterator[T]) Next() (result T, ok bool) {
+	v.checkMod()
+	if v.next == nil {
+		return result, false
+	}
+	v.lastReturned = v.next
+	v.next = v.Src.bounds.Next(v.next)
+	return v.lastReturned.Key, true
+}
+
+func (v *TreeSetIterator[T]) remove() {
+	// Deleting a node with two children moves its successor into it
+	if !v.Src.bounds.Descending && v.next != nil && v.lastReturned.HasTwoChildren() {
+		v.next = v.lastReturned
+	}
+	v.Src.data.tree.Delete(v.lastReturned)
+	v.lastReturned = nil
+}
+
+func (v *TreeSetIterator[T]) Remove() {
+	v.checkMod()
+	defer v.applyMod()
+	if v.lastReturned == nil {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	v.remove()
+}
+
+// Removes current value and adds the new value. New value might be visited later in the iteration
+func (v *TreeSetIterator[T]) Set(data T) T {
+	v.checkMod()
+	defer v.applyMod()
+	if v.lastReturned == nil {
+		panic("Don't call set before reading")
+	}
+	v.Src.bounds.RangeCheck(data)
+	old := v.lastReturned.Key
+	v.remove()
+	v.Src.data.tree.Put(data, struct{}{})
+	return old
+}
+
+func NewTreeSetIteratorFor[T comparable](v *TreeSet[T]) coll.Iterator[T] {
+	return &TreeSetIterator[T]{Src: v, generation: v.data.generation, next: v.bounds.First()}
+}
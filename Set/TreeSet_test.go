@@ -0,0 +1,98 @@
+package Set
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	"github.com/wushilin/gojava/common"
+)
+
+func intCompare(a, b int) int {
+	return a - b
+}
+
+func TestTreeSet(t *testing.T) {
+	set := NewTreeSet(intCompare)
+	reference := NewHashSet[int]()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		next := rnd.Intn(1000)
+		if rnd.Intn(3) == 0 {
+			common.AssertEq(t, set.Remove(next), reference.Remove(next))
+		} else {
+			common.AssertEq(t, set.Add(next), reference.Add(next))
+		}
+	}
+	expected := reference.ToArray()
+	sort.Ints(expected)
+	common.AssertEq(t, set.Size(), reference.Size())
+	common.AssertArrEq(t, set.ToArray(), expected)
+
+	iter := set.Iterator()
+	for item, ok := iter.Next(); ok; item, ok = iter.Next() {
+		if item%3 != 0 {
+			iter.Remove()
+		}
+	}
+	set.ForEach(func(i int) bool {
+		common.AssertEq(t, i%3, 0)
+		return true
+	})
+	coll.PrintCollection[int](set.HeadSet(100, true))
+}
+
+func TestTreeSetNavigation(t *testing.T) {
+	set := TreeSetOf(intCompare, 50, 10, 40, 20, 30)
+	first, _ := set.First()
+	last, _ := set.Last()
+	common.AssertEq(t, first, 10)
+	common.AssertEq(t, last, 50)
+	floor, _ := set.Floor(35)
+	ceiling, _ := set.Ceiling(35)
+	lower, _ := set.Lower(20)
+	higher, _ := set.Higher(20)
+	common.AssertEq(t, floor, 30)
+	common.AssertEq(t, ceiling, 40)
+	common.AssertEq(t, lower, 10)
+	common.AssertEq(t, higher, 30)
+
+	common.AssertArrEq(t, set.HeadSet(30, false).ToArray(), []int{10, 20})
+	common.AssertArrEq(t, set.TailSet(30, true).ToArray(), []int{30, 40, 50})
+	common.AssertArrEq(t, set.SubSet(10, false, 50, false).ToArray(), []int{20, 30, 40})
+	common.AssertArrEq(t, set.DescendingSet().ToArray(), []int{50, 40, 30, 20, 10})
+
+	// Views stay live against the backing set
+	sub := set.SubSet(15, true, 45, true)
+	set.Add(25)
+	common.AssertTrue(t, sub.Contains(25))
+	common.AssertEq(t, sub.Size(), 4)
+	sub.Add(35)
+	common.AssertTrue(t, set.Contains(35))
+	polled, _ := sub.PollFirst()
+	common.AssertEq(t, polled, 20)
+	common.AssertFalse(t, set.Contains(20))
+	polled, _ = sub.PollLast()
+	common.AssertEq(t, polled, 40)
+	sub.Clear()
+	common.AssertArrEq(t, set.ToArray(), []int{10, 50})
+
+	defer func() {
+		common.AssertTrue(t, recover() != nil)
+	}()
+	sub.Add(100)
+}
+
+func TestTreeSetIteratorSet(t *testing.T) {
+	set := TreeSetOf(intCompare, 1, 2, 3)
+	iter := set.Iterator()
+	item, _ := iter.Next()
+	common.AssertEq(t, iter.Set(item+10), 1)
+	common.AssertArrEq(t, set.ToArray(), []int{2, 3, 11})
+	set.Add(4)
+	defer func() {
+		common.AssertTrue(t, recover() != nil)
+	}()
+	iter.Next()
+}
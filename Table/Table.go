@@ -0,0 +1,85 @@
+package Table
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	mp "github.com/wushilin/gojava/Map"
+	set "github.com/wushilin/gojava/Set"
+)
+
+// A map with two keys, a row and a column, like a sparse matrix. Size() counts the cells that have a value
+type Table[R comparable, C comparable, V any] interface {
+	// Return the number of cells
+	Size() int
+
+	// Whether the table has no cells
+	IsEmpty() bool
+
+	// Whether there is a value at row and column
+	Contains(row R, column C) bool
+
+	// Whether the row has at least one value
+	ContainsRow(row R) bool
+
+	// Whether the column has at least one value
+	ContainsColumn(column C) bool
+
+	// Test if a value is found in any cell. It iterates the values
+	ContainsValue(value V) bool
+
+	// Get the value at row and column, if no result found, ok is set to false
+	Get(row R, column C) (value V, ok bool)
+
+	// Put the value at row and column
+	Put(row R, column C, value V)
+
+	// Remove the value at row and column. Returns the removed value, and whether there was one
+	Remove(row R, column C) (oldValue V, removed bool)
+
+	// Removes all cells
+	Clear()
+
+	// Return a live view of a row, from column to value. Putting into it puts into the table
+	Row(row R) mp.Map[C, V]
+
+	// Return a live view of a column, from row to value. Putting into it puts into the table
+	Column(column C) mp.Map[R, V]
+
+	// Return a live view of the rows that have values. Removing a row removes all of its cells
+	RowKeySet() set.Set[R]
+
+	// Return a snapshot of the columns that have values
+	ColumnKeySet() set.Set[C]
+
+	// Return a snapshot of the cells, row by row
+	CellSet() coll.Collection[Cell[R, C, V]]
+}
+
+// A cell of a Table
+type Cell[R any, C any, V any] interface {
+	Row() R
+	Column() C
+	Value() V
+}
+
+type cell[R any, C any, V any] struct {
+	row    R
+	column C
+	value  V
+}
+
+func (v *cell[R, C, V]) Row() R {
+	return v.row
+}
+
+func (v *cell[R, C, V]) Column() C {
+	return v.column
+}
+
+func (v *cell[R, C, V]) Value() V {
+	return v.value
+}
+
+// Returns a Cell of row, column and value
+func CellOf[R any, C any, V any](row R, column C, value V) Cell[R, C, V] {
+	return &cell[R, C, V]{row, column, value}
+}
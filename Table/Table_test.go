@@ -0,0 +1,93 @@
+package Table
+
+import (
+	"testing"
+
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	"github.com/wushilin/gojava/common"
+)
+
+var _ Table[string, string, int] = NewHashTable[string, string, int]()
+var _ Table[string, string, int] = NewTreeTable[string, string, int](coll.NaturalOrder[string](), coll.NaturalOrder[string]())
+
+func TestTable(t *testing.T) {
+	tables := map[string]Table[string, string, int]{
+		"HashTable": NewHashTable[string, string, int](),
+		"TreeTable": NewTreeTable[string, string, int](coll.NaturalOrder[string](), coll.NaturalOrder[string]()),
+	}
+	for name, table := range tables {
+		t.Run(name, func(t *testing.T) {
+			table.Put("eu", "cpu", 1)
+			table.Put("eu", "mem", 2)
+			table.Put("us", "cpu", 3)
+			common.AssertEq(t, table.Size(), 3)
+			value, ok := table.Get("eu", "mem")
+			common.AssertTrue(t, ok)
+			common.AssertEq(t, value, 2)
+			_, ok = table.Get("us", "mem")
+			common.AssertFalse(t, ok)
+			common.AssertTrue(t, table.Contains("us", "cpu"))
+			common.AssertTrue(t, table.ContainsRow("eu"))
+			common.AssertTrue(t, table.ContainsColumn("mem"))
+			common.AssertTrue(t, table.ContainsValue(3))
+			common.AssertEq(t, table.RowKeySet().Size(), 2)
+			common.AssertEq(t, table.ColumnKeySet().Size(), 2)
+			common.AssertEq(t, table.CellSet().Size(), 3)
+
+			old, removed := table.Remove("us", "cpu")
+			common.AssertTrue(t, removed)
+			common.AssertEq(t, old, 3)
+			common.AssertFalse(t, table.ContainsRow("us"))
+			_, removed = table.Remove("us", "cpu")
+			common.AssertFalse(t, removed)
+
+			table.Clear()
+			common.AssertTrue(t, table.IsEmpty())
+		})
+	}
+}
+
+func TestRowAndColumnViews(t *testing.T) {
+	table := NewTreeTable[string, string, int](coll.NaturalOrder[string](), coll.NaturalOrder[string]())
+	row := table.Row("eu")
+	column := table.Column("cpu")
+	common.AssertEq(t, row.Size(), 0)
+
+	row.Put("cpu", 1)
+	row.Put("mem", 2)
+	column.Put("us", 3)
+	common.AssertEq(t, table.Size(), 3)
+	common.AssertEq(t, column.Size(), 2)
+	common.AssertArrEq(t, column.Keys().ToArray(), []string{"eu", "us"})
+	common.AssertArrEq(t, table.ColumnKeySet().ToArray(), []string{"cpu", "mem"})
+
+	column.ReplaceAll(func(key string, value int) int {
+		return value * 10
+	})
+	common.AssertEq(t, row.GetOrDefault("cpu", 0), 10)
+	common.AssertEq(t, table.Row("us").GetOrDefault("cpu", 0), 30)
+
+	iter := row.Iterator()
+	for _, ok := iter.Next(); ok; _, ok = iter.Next() {
+		iter.Remove()
+	}
+	common.AssertFalse(t, table.ContainsRow("eu"))
+	common.AssertEq(t, column.Size(), 1)
+
+	column.KeySet().RemoveAll(list.ArrayListOf("us"))
+	common.AssertTrue(t, table.IsEmpty())
+
+	table.Put("eu", "cpu", 1)
+	columnIter := column.Iterator()
+	columnIter.Next()
+	table.Put("us", "cpu", 2)
+	panicked := false
+	func() {
+		defer func() {
+			panicked = recover() != nil
+		}()
+		columnIter.Next()
+	}()
+	common.AssertTrue(t, panicked)
+}
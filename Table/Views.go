@@ -0,0 +1,325 @@
+package Table
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	mp "github.com/wushilin/gojava/Map"
+	set "github.com/wushilin/gojava/Set"
+	"github.com/wushilin/stream"
+)
+
+// Live view of a row, from column to value. A row without cells reads as an empty map
+type rowView[R comparable, C comparable, V any] struct {
+	src *cells[R, C, V]
+	row R
+}
+
+// The current columns of the row, a new empty map if it has none
+func (v *rowView[R, C, V]) columns() mp.Map[C, V] {
+	if columns, ok := v.src.data.Get(v.row); ok {
+		return columns
+	}
+	return v.src.newRow()
+}
+
+func (v *rowView[R, C, V]) Size() int {
+	return v.columns().Size()
+}
+
+func (v *rowView[R, C, V]) Contains(key C) bool {
+	return v.src.Contains(v.row, key)
+}
+
+func (v *rowView[R, C, V]) Get(key C) (V, bool) {
+	return v.src.Get(v.row, key)
+}
+
+func (v *rowView[R, C, V]) Put(key C, value V) {
+	v.src.Put(v.row, key, value)
+}
+
+func (v *rowView[R, C, V]) PutAll(other mp.Map[C, V]) {
+	other.ForEach(func(key C, value V) bool {
+		v.Put(key, value)
+		return true
+	})
+}
+
+func (v *rowView[R, C, V]) Remove(key C) (oldValue V, removed bool) {
+	return v.src.Remove(v.row, key)
+}
+
+func (v *rowView[R, C, V]) GetOrDefault(key C, defaultValue V) V {
+	return mp.GetOrDefault[C, V](v, key, defaultValue)
+}
+
+func (v *rowView[R, C, V]) PutIfAbsent(key C, value V) (previous V, present bool) {
+	return mp.PutIfAbsent[C, V](v, key, value)
+}
+
+func (v *rowView[R, C, V]) ComputeIfAbsent(key C, mapping func(key C) V) V {
+	return mp.ComputeIfAbsent[C, V](v, key, mapping)
+}
+
+func (v *rowView[R, C, V]) ComputeIfPresent(key C, remapping func(key C, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	return mp.ComputeIfPresent[C, V](v, key, remapping)
+}
+
+func (v *rowView[R, C, V]) Compute(key C, remapping func(key C, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	return mp.Compute[C, V](v, key, remapping)
+}
+
+func (v *rowView[R, C, V]) Merge(key C, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	return mp.Merge[C, V](v, key, value, remapping)
+}
+
+func (v *rowView[R, C, V]) Replace(key C, value V) (oldValue V, replaced bool) {
+	return mp.Replace[C, V](v, key, value)
+}
+
+func (v *rowView[R, C, V]) ReplaceAll(function func(key C, value V) V) {
+	mp.ReplaceAll[C, V](v, function)
+}
+
+func (v *rowView[R, C, V]) ForEach(visitor func(key C, value V) bool) int {
+	return mp.ForEach[C, V](v, visitor)
+}
+
+func (v *rowView[R, C, V]) RemoveAll(keys coll.Collection[C]) {
+	keys.ForEach(func(i C) bool {
+		v.Remove(i)
+		return true
+	})
+}
+
+func (v *rowView[R, C, V]) ContainsValueFunc(value V, equals coll.Equalizer[V]) bool {
+	return v.columns().ContainsValueFunc(value, equals)
+}
+
+func (v *rowView[R, C, V]) ContainsValue(value V) bool {
+	return v.columns().ContainsValue(value)
+}
+
+func (v *rowView[R, C, V]) Iterator() coll.Iterator[mp.KV[C, V]] {
+	return &rowIterator[R, C, V]{v.columns().Iterator(), v}
+}
+
+func (v *rowView[R, C, V]) Keys() set.Set[C] {
+	return v.columns().Keys()
+}
+
+func (v *rowView[R, C, V]) Values() coll.Collection[V] {
+	return v.columns().Values()
+}
+
+func (v *rowView[R, C, V]) Stream() stream.Stream[mp.KV[C, V]] {
+	return stream.FromIterator[mp.KV[C, V]](v.Iterator())
+}
+
+func (v *rowView[R, C, V]) KeySet() set.Set[C] {
+	return mp.KeySet[C, V](v)
+}
+
+func (v *rowView[R, C, V]) ValuesView() coll.Collection[V] {
+	return mp.ValuesView[C, V](v)
+}
+
+func (v *rowView[R, C, V]) EntrySet() coll.Collection[mp.Entry[C, V]] {
+	return mp.EntrySet[C, V](v)
+}
+
+// Iterator of the row map, Remove() also drops the row from the table once it is empty
+type rowIterator[R comparable, C comparable, V any] struct {
+	coll.Iterator[mp.KV[C, V]]
+	view *rowView[R, C, V]
+}
+
+func (v *rowIterator[R, C, V]) Remove() {
+	v.Iterator.Remove()
+	v.view.src.applyMod()
+	v.view.src.prune(v.view.row)
+}
+
+// Live view of a column, from row to value. It scans the rows, so Size() and iteration take time in the number of rows
+type columnView[R comparable, C comparable, V any] struct {
+	src    *cells[R, C, V]
+	column C
+}
+
+// Snapshot of the rows that have a value in the column
+func (v *columnView[R, C, V]) rows() []R {
+	result := make([]R, 0)
+	v.src.data.ForEach(func(row R, columns mp.Map[C, V]) bool {
+		if columns.Contains(v.column) {
+			result = append(result, row)
+		}
+		return true
+	})
+	return result
+}
+
+func (v *columnView[R, C, V]) Size() int {
+	return len(v.rows())
+}
+
+func (v *columnView[R, C, V]) Contains(key R) bool {
+	return v.src.Contains(key, v.column)
+}
+
+func (v *columnView[R, C, V]) Get(key R) (V, bool) {
+	return v.src.Get(key, v.column)
+}
+
+func (v *columnView[R, C, V]) Put(key R, value V) {
+	v.src.Put(key, v.column, value)
+}
+
+func (v *columnView[R, C, V]) PutAll(other mp.Map[R, V]) {
+	other.ForEach(func(key R, value V) bool {
+		v.Put(key, value)
+		return true
+	})
+}
+
+func (v *columnView[R, C, V]) Remove(key R) (oldValue V, removed bool) {
+	return v.src.Remove(key, v.column)
+}
+
+func (v *columnView[R, C, V]) GetOrDefault(key R, defaultValue V) V {
+	return mp.GetOrDefault[R, V](v, key, defaultValue)
+}
+
+func (v *columnView[R, C, V]) PutIfAbsent(key R, value V) (previous V, present bool) {
+	return mp.PutIfAbsent[R, V](v, key, value)
+}
+
+func (v *columnView[R, C, V]) ComputeIfAbsent(key R, mapping func(key R) V) V {
+	return mp.ComputeIfAbsent[R, V](v, key, mapping)
+}
+
+func (v *columnView[R, C, V]) ComputeIfPresent(key R, remapping func(key R, oldValue V) (newValue V, keep bool)) (result V, present bool) {
+	return mp.ComputeIfPresent[R, V](v, key, remapping)
+}
+
+func (v *columnView[R, C, V]) Compute(key R, remapping func(key R, oldValue V, exists bool) (newValue V, keep bool)) (result V, present bool) {
+	return mp.Compute[R, V](v, key, remapping)
+}
+
+func (v *columnView[R, C, V]) Merge(key R, value V, remapping func(oldValue V, value V) (newValue V, keep bool)) (result V, present bool) {
+	return mp.Merge[R, V](v, key, value, remapping)
+}
+
+func (v *columnView[R, C, V]) Replace(key R, value V) (oldValue V, replaced bool) {
+	return mp.Replace[R, V](v, key, value)
+}
+
+func (v *columnView[R, C, V]) ReplaceAll(function func(key R, value V) V) {
+	mp.ReplaceAll[R, V](v, function)
+}
+
+func (v *columnView[R, C, V]) ForEach(visitor func(key R, value V) bool) int {
+	return mp.ForEach[R, V](v, visitor)
+}
+
+func (v *columnView[R, C, V]) RemoveAll(keys coll.Collection[R]) {
+	keys.ForEach(func(i R) bool {
+		v.Remove(i)
+		return true
+	})
+}
+
+func (v *columnView[R, C, V]) ContainsValueFunc(value V, equals coll.Equalizer[V]) bool {
+	return v.Values().ContainsFunc(value, equals)
+}
+
+func (v *columnView[R, C, V]) ContainsValue(value V) bool {
+	return v.ContainsValueFunc(value, coll.DefaultEqualizer[V]())
+}
+
+func (v *columnView[R, C, V]) Iterator() coll.Iterator[mp.KV[R, V]] {
+	return &columnIterator[R, C, V]{view: v, generation: v.src.generation, lastIndex: -1, rows: v.rows()}
+}
+
+// Returns a snapshot of the rows that have a value in the column, in row order
+func (v *columnView[R, C, V]) Keys() set.Set[R] {
+	return set.LinkedHashSetOf(v.rows()...)
+}
+
+func (v *columnView[R, C, V]) Values() coll.Collection[V] {
+	result := list.NewArrayList[V]()
+	v.ForEach(func(key R, value V) bool {
+		result.Add(value)
+		return true
+	})
+	return result
+}
+
+func (v *columnView[R, C, V]) Stream() stream.Stream[mp.KV[R, V]] {
+	return stream.FromIterator[mp.KV[R, V]](v.Iterator())
+}
+
+func (v *columnView[R, C, V]) KeySet() set.Set[R] {
+	return mp.KeySet[R, V](v)
+}
+
+func (v *columnView[R, C, V]) ValuesView() coll.Collection[V] {
+	return mp.ValuesView[R, V](v)
+}
+
+func (v *columnView[R, C, V]) EntrySet() coll.Collection[mp.Entry[R, V]] {
+	return mp.EntrySet[R, V](v)
+}
+
+// Iterates a snapshot of the rows that have a value in the column, values are read in realtime
+type columnIterator[R comparable, C comparable, V any] struct {
+	view         *columnView[R, C, V]
+	generation   int
+	currentIndex int
+	lastIndex    int
+	rows         []R
+}
+
+func (v *columnIterator[R, C, V]) checkMod() {
+	if v.generation != v.view.src.generation {
+		panic("Concurrent modification")
+	}
+}
+
+func (v *columnIterator[R, C, V]) Next() (result mp.KV[R, V], ok bool) {
+	v.checkMod()
+	for v.currentIndex < len(v.rows) {
+		row := v.rows[v.currentIndex]
+		v.currentIndex++
+		// The row may have been removed through RowKeySet()
+		if value, found := v.view.Get(row); found {
+			v.lastIndex = v.currentIndex - 1
+			return mp.KVOf(row, value), true
+		}
+	}
+	return result, false
+}
+
+func (v *columnIterator[R, C, V]) Remove() {
+	v.checkMod()
+	if v.lastIndex == -1 {
+		panic("Don't call remove before reading, and don't remove twice")
+	}
+	v.view.Remove(v.rows[v.lastIndex])
+	v.lastIndex = -1
+	v.generation = v.view.src.generation
+}
+
+func (v *columnIterator[R, C, V]) Set(data mp.KV[R, V]) mp.KV[R, V] {
+	v.checkMod()
+	if v.lastIndex == -1 {
+		panic("Don't call set before reading")
+	}
+	row := v.rows[v.lastIndex]
+	if row != data.Key() {
+		panic("Map iterator.Set must set the same key!")
+	}
+	oldValue, _ := v.view.Get(row)
+	v.view.Put(row, data.Value())
+	v.generation = v.view.src.generation
+	return mp.KVOf(row, oldValue)
+}
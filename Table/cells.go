@@ -0,0 +1,171 @@
+package Table
+
+import (
+	coll "github.com/wushilin/gojava/Collection"
+	list "github.com/wushilin/gojava/List"
+	mp "github.com/wushilin/gojava/Map"
+	set "github.com/wushilin/gojava/Set"
+)
+
+// The Table implementation shared by the variants. It keeps a map of rows, each row maps columns to values.
+// Rows are created by the first Put and removed when their last cell is removed
+type cells[R comparable, C comparable, V any] struct {
+	data         mp.Map[R, mp.Map[C, V]]
+	newRow       func() mp.Map[C, V]
+	newColumnSet func() set.Set[C]
+	generation   int
+}
+
+func (v *cells[R, C, V]) applyMod() {
+	v.generation++
+}
+
+// Drop row if it has no cells left
+func (v *cells[R, C, V]) prune(row R) {
+	if columns, ok := v.data.Get(row); ok && columns.Size() == 0 {
+		v.data.Remove(row)
+	}
+}
+
+func (v *cells[R, C, V]) Size() int {
+	count := 0
+	v.data.ForEach(func(row R, columns mp.Map[C, V]) bool {
+		count += columns.Size()
+		return true
+	})
+	return count
+}
+
+func (v *cells[R, C, V]) IsEmpty() bool {
+	return v.data.Size() == 0
+}
+
+func (v *cells[R, C, V]) Contains(row R, column C) bool {
+	columns, ok := v.data.Get(row)
+	return ok && columns.Contains(column)
+}
+
+func (v *cells[R, C, V]) ContainsRow(row R) bool {
+	return v.data.Contains(row)
+}
+
+func (v *cells[R, C, V]) ContainsColumn(column C) bool {
+	found := false
+	v.data.ForEach(func(row R, columns mp.Map[C, V]) bool {
+		found = columns.Contains(column)
+		return !found
+	})
+	return found
+}
+
+func (v *cells[R, C, V]) ContainsValue(value V) bool {
+	found := false
+	v.data.ForEach(func(row R, columns mp.Map[C, V]) bool {
+		found = columns.ContainsValue(value)
+		return !found
+	})
+	return found
+}
+
+func (v *cells[R, C, V]) Get(row R, column C) (result V, ok bool) {
+	columns, ok := v.data.Get(row)
+	if !ok {
+		return
+	}
+	return columns.Get(column)
+}
+
+func (v *cells[R, C, V]) Put(row R, column C, value V) {
+	defer v.applyMod()
+	columns, ok := v.data.Get(row)
+	if !ok {
+		columns = v.newRow()
+		v.data.Put(row, columns)
+	}
+	columns.Put(column, value)
+}
+
+func (v *cells[R, C, V]) Remove(row R, column C) (oldValue V, removed bool) {
+	columns, ok := v.data.Get(row)
+	if !ok {
+		return
+	}
+	oldValue, removed = columns.Remove(column)
+	if removed {
+		v.applyMod()
+		v.prune(row)
+	}
+	return
+}
+
+func (v *cells[R, C, V]) Clear() {
+	defer v.applyMod()
+	v.data.KeySet().Clear()
+}
+
+func (v *cells[R, C, V]) Row(row R) mp.Map[C, V] {
+	return &rowView[R, C, V]{v, row}
+}
+
+func (v *cells[R, C, V]) Column(column C) mp.Map[R, V] {
+	return &columnView[R, C, V]{v, column}
+}
+
+func (v *cells[R, C, V]) RowKeySet() set.Set[R] {
+	return v.data.KeySet()
+}
+
+func (v *cells[R, C, V]) ColumnKeySet() set.Set[C] {
+	result := v.newColumnSet()
+	v.data.ForEach(func(row R, columns mp.Map[C, V]) bool {
+		result.AddAll(columns.Keys())
+		return true
+	})
+	return result
+}
+
+func (v *cells[R, C, V]) CellSet() coll.Collection[Cell[R, C, V]] {
+	result := list.NewArrayList[Cell[R, C, V]]()
+	v.data.ForEach(func(row R, columns mp.Map[C, V]) bool {
+		columns.ForEach(func(column C, value V) bool {
+			result.Add(CellOf(row, column, value))
+			return true
+		})
+		return true
+	})
+	return result
+}
+
+// A Table backed by a HashMap of HashMaps, rows and columns are in no particular order
+type HashTable[R comparable, C comparable, V any] struct {
+	cells[R, C, V]
+}
+
+func NewHashTable[R comparable, C comparable, V any]() *HashTable[R, C, V] {
+	return &HashTable[R, C, V]{cells[R, C, V]{
+		data:         mp.NewHashMap[R, mp.Map[C, V]](),
+		newRow:       mp.NewHashMap[C, V],
+		newColumnSet: newHashSet[C],
+	}}
+}
+
+func newHashSet[T comparable]() set.Set[T] {
+	return set.NewHashSet[T]()
+}
+
+// A Table backed by a TreeMap of TreeMaps, rows and columns are ordered by their comparators
+type TreeTable[R comparable, C comparable, V any] struct {
+	cells[R, C, V]
+}
+
+func NewTreeTable[R comparable, C comparable, V any](rowComparator coll.Comparator[R], columnComparator coll.Comparator[C]) *TreeTable[R, C, V] {
+	return &TreeTable[R, C, V]{cells[R, C, V]{
+		data: mp.NewTreeMap[R, mp.Map[C, V]](rowComparator),
+		newRow: func() mp.Map[C, V] {
+			return mp.NewTreeMap[C, V](columnComparator)
+		},
+		newColumnSet: func() set.Set[C] {
+			return set.NewTreeSet(columnComparator)
+		},
+	}}
+}
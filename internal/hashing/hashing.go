@@ -0,0 +1,137 @@
+// Package hashing hashes arbitrary values with reflection, for the hash based containers
+package hashing
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// Pointers are followed this many levels deep by Deep, so cyclic values still hash
+const maxPointerDepth = 8
+
+var seed = maphash.MakeSeed()
+
+// Hash a comparable value, so that equal values (by ==) have equal hashes.
+// Common types are hashed directly, everything else is walked with reflection.
+// Pointers are hashed by address
+func Comparable[K comparable](key K) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	switch k := any(key).(type) {
+	case string:
+		h.WriteString(k)
+	case int:
+		writeUint64(&h, uint64(k))
+	case int64:
+		writeUint64(&h, uint64(k))
+	case uint64:
+		writeUint64(&h, k)
+	default:
+		writeValue(&h, reflect.ValueOf(&key).Elem(), false, 0)
+	}
+	return h.Sum64()
+}
+
+// Hash any value, so that values equal by reflect.DeepEqual have equal hashes.
+// Slices and maps are hashed by content, pointers by what they point to
+func Deep[T any](value T) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	writeValue(&h, reflect.ValueOf(&value).Elem(), true, 0)
+	return h.Sum64()
+}
+
+func writeUint64(h *maphash.Hash, value uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], value)
+	h.Write(buf[:])
+}
+
+func writeFloat(h *maphash.Hash, value float64) {
+	if value == 0 {
+		// +0 and -0 are equal
+		value = 0
+	}
+	writeUint64(h, math.Float64bits(value))
+}
+
+func writeValue(h *maphash.Hash, value reflect.Value, deep bool, depth int) {
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			writeUint64(h, 1)
+		} else {
+			writeUint64(h, 0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, value.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(h, value.Float())
+	case reflect.Complex64, reflect.Complex128:
+		writeFloat(h, real(value.Complex()))
+		writeFloat(h, imag(value.Complex()))
+	case reflect.String:
+		h.WriteString(value.String())
+	case reflect.Pointer:
+		if !deep {
+			writeUint64(h, uint64(value.Pointer()))
+		} else if value.IsNil() {
+			writeUint64(h, 0)
+		} else if depth < maxPointerDepth {
+			writeValue(h, value.Elem(), deep, depth+1)
+		}
+	case reflect.Chan, reflect.UnsafePointer:
+		writeUint64(h, uint64(value.Pointer()))
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			writeValue(h, value.Index(i), deep, depth)
+		}
+	case reflect.Slice:
+		if !deep {
+			panic("Can't hash " + value.Type().String())
+		}
+		writeUint64(h, uint64(value.Len()))
+		for i := 0; i < value.Len(); i++ {
+			writeValue(h, value.Index(i), deep, depth)
+		}
+	case reflect.Map:
+		if !deep {
+			panic("Can't hash " + value.Type().String())
+		}
+		// Map order is random, so entries are hashed on their own and summed up
+		var sum uint64
+		iter := value.MapRange()
+		for iter.Next() {
+			var entry maphash.Hash
+			entry.SetSeed(seed)
+			writeValue(&entry, iter.Key(), deep, depth)
+			writeValue(&entry, iter.Value(), deep, depth)
+			sum += entry.Sum64()
+		}
+		writeUint64(h, uint64(value.Len()))
+		writeUint64(h, sum)
+	case reflect.Func:
+		if !deep {
+			panic("Can't hash " + value.Type().String())
+		}
+		// Functions are only equal when both are nil
+		writeUint64(h, 0)
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			writeValue(h, value.Field(i), deep, depth)
+		}
+	case reflect.Interface:
+		if value.IsNil() {
+			writeUint64(h, 0)
+			return
+		}
+		h.WriteString(value.Elem().Type().String())
+		writeValue(h, value.Elem(), deep, depth)
+	default:
+		panic("Can't hash " + value.Type().String())
+	}
+}
@@ -0,0 +1,259 @@
+package rbtree
+
+// A range over a Tree, optionally walked in descending order.
+// Bounds are always expressed in the ascending order of the tree.
+type Range[K any, V any] struct {
+	Tree        *Tree[K, V]
+	fromStart   bool
+	lo          K
+	loInclusive bool
+	toEnd       bool
+	hi          K
+	hiInclusive bool
+	Descending  bool
+}
+
+// Return a range covering the whole tree
+func (v *Tree[K, V]) All() Range[K, V] {
+	return Range[K, V]{Tree: v, fromStart: true, toEnd: true}
+}
+
+func (v Range[K, V]) tooLow(key K) bool {
+	if v.fromStart {
+		return false
+	}
+	cmp := v.Tree.Comparator(key, v.lo)
+	return cmp < 0 || (cmp == 0 && !v.loInclusive)
+}
+
+func (v Range[K, V]) tooHigh(key K) bool {
+	if v.toEnd {
+		return false
+	}
+	cmp := v.Tree.Comparator(key, v.hi)
+	return cmp > 0 || (cmp == 0 && !v.hiInclusive)
+}
+
+func (v Range[K, V]) InRange(key K) bool {
+	return !v.tooLow(key) && !v.tooHigh(key)
+}
+
+// Panics if key is not in range
+func (v Range[K, V]) RangeCheck(key K) {
+	if !v.InRange(key) {
+		panic("Key out of range")
+	}
+}
+
+func (v Range[K, V]) boundInRange(key K, inclusive bool) bool {
+	if !v.fromStart {
+		cmp := v.Tree.Comparator(key, v.lo)
+		if cmp < 0 || (cmp == 0 && !v.loInclusive && inclusive) {
+			return false
+		}
+	}
+	if !v.toEnd {
+		cmp := v.Tree.Comparator(key, v.hi)
+		if cmp > 0 || (cmp == 0 && !v.hiInclusive && inclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v Range[K, V]) absLowest() *Node[K, V] {
+	var node *Node[K, V]
+	if v.fromStart {
+		node = v.Tree.First()
+	} else if v.loInclusive {
+		node = v.Tree.Ceiling(v.lo)
+	} else {
+		node = v.Tree.Higher(v.lo)
+	}
+	if node == nil || v.tooHigh(node.Key) {
+		return nil
+	}
+	return node
+}
+
+func (v Range[K, V]) absHighest() *Node[K, V] {
+	var node *Node[K, V]
+	if v.toEnd {
+		node = v.Tree.Last()
+	} else if v.hiInclusive {
+		node = v.Tree.Floor(v.hi)
+	} else {
+		node = v.Tree.Lower(v.hi)
+	}
+	if node == nil || v.tooLow(node.Key) {
+		return nil
+	}
+	return node
+}
+
+func (v Range[K, V]) absCeiling(key K) *Node[K, V] {
+	if v.tooLow(key) {
+		return v.absLowest()
+	}
+	node := v.Tree.Ceiling(key)
+	if node == nil || v.tooHigh(node.Key) {
+		return nil
+	}
+	return node
+}
+
+func (v Range[K, V]) absHigher(key K) *Node[K, V] {
+	if v.tooLow(key) {
+		return v.absLowest()
+	}
+	node := v.Tree.Higher(key)
+	if node == nil || v.tooHigh(node.Key) {
+		return nil
+	}
+	return node
+}
+
+func (v Range[K, V]) absFloor(key K) *Node[K, V] {
+	if v.tooHigh(key) {
+		return v.absHighest()
+	}
+	node := v.Tree.Floor(key)
+	if node == nil || v.tooLow(node.Key) {
+		return nil
+	}
+	return node
+}
+
+func (v Range[K, V]) absLower(key K) *Node[K, V] {
+	if v.tooHigh(key) {
+		return v.absHighest()
+	}
+	node := v.Tree.Lower(key)
+	if node == nil || v.tooLow(node.Key) {
+		return nil
+	}
+	return node
+}
+
+// First node in walking order
+func (v Range[K, V]) First() *Node[K, V] {
+	if v.Descending {
+		return v.absHighest()
+	}
+	return v.absLowest()
+}
+
+// Last node in walking order
+func (v Range[K, V]) Last() *Node[K, V] {
+	if v.Descending {
+		return v.absLowest()
+	}
+	return v.absHighest()
+}
+
+// Node after node in walking order, nil when the end of range is reached
+func (v Range[K, V]) Next(node *Node[K, V]) *Node[K, V] {
+	if v.Descending {
+		node = node.Prev()
+	} else {
+		node = node.Next()
+	}
+	if node == nil || !v.InRange(node.Key) {
+		return nil
+	}
+	return node
+}
+
+// Greatest node <= key in walking order
+func (v Range[K, V]) Floor(key K) *Node[K, V] {
+	if v.Descending {
+		return v.absCeiling(key)
+	}
+	return v.absFloor(key)
+}
+
+// Least node >= key in walking order
+func (v Range[K, V]) Ceiling(key K) *Node[K, V] {
+	if v.Descending {
+		return v.absFloor(key)
+	}
+	return v.absCeiling(key)
+}
+
+// Greatest node < key in walking order
+func (v Range[K, V]) Lower(key K) *Node[K, V] {
+	if v.Descending {
+		return v.absHigher(key)
+	}
+	return v.absLower(key)
+}
+
+// Least node > key in walking order
+func (v Range[K, V]) Higher(key K) *Node[K, V] {
+	if v.Descending {
+		return v.absLower(key)
+	}
+	return v.absHigher(key)
+}
+
+// Count nodes in range
+func (v Range[K, V]) Size() int {
+	if v.fromStart && v.toEnd {
+		return v.Tree.Size()
+	}
+	count := 0
+	for node := v.First(); node != nil; node = v.Next(node) {
+		count++
+	}
+	return count
+}
+
+func (v Range[K, V]) sub(fromStart bool, lo K, loInclusive bool, toEnd bool, hi K, hiInclusive bool) Range[K, V] {
+	if !fromStart && !v.boundInRange(lo, loInclusive) {
+		panic("Key out of range")
+	}
+	if !toEnd && !v.boundInRange(hi, hiInclusive) {
+		panic("Key out of range")
+	}
+	if !fromStart && !toEnd && v.Tree.Comparator(lo, hi) > 0 {
+		panic("fromKey > toKey")
+	}
+	result := v
+	if !fromStart {
+		result.fromStart, result.lo, result.loInclusive = false, lo, loInclusive
+	}
+	if !toEnd {
+		result.toEnd, result.hi, result.hiInclusive = false, hi, hiInclusive
+	}
+	return result
+}
+
+// Narrow the range to the keys before toKey in walking order
+func (v Range[K, V]) Head(toKey K, inclusive bool) Range[K, V] {
+	if v.Descending {
+		return v.sub(false, toKey, inclusive, true, toKey, false)
+	}
+	return v.sub(true, toKey, false, false, toKey, inclusive)
+}
+
+// Narrow the range to the keys after fromKey in walking order
+func (v Range[K, V]) Tail(fromKey K, inclusive bool) Range[K, V] {
+	if v.Descending {
+		return v.sub(true, fromKey, false, false, fromKey, inclusive)
+	}
+	return v.sub(false, fromKey, inclusive, true, fromKey, false)
+}
+
+// Narrow the range to the keys from fromKey to toKey in walking order
+func (v Range[K, V]) Sub(fromKey K, fromInclusive bool, toKey K, toInclusive bool) Range[K, V] {
+	if v.Descending {
+		return v.sub(false, toKey, toInclusive, false, fromKey, fromInclusive)
+	}
+	return v.sub(false, fromKey, fromInclusive, false, toKey, toInclusive)
+}
+
+// Same range walked in the opposite order
+func (v Range[K, V]) Reversed() Range[K, V] {
+	v.Descending = !v.Descending
+	return v
+}
@@ -0,0 +1,418 @@
+// Package rbtree is the red-black tree shared by TreeMap and TreeSet
+package rbtree
+
+type Node[K any, V any] struct {
+	Key    K
+	Value  V
+	left   *Node[K, V]
+	right  *Node[K, V]
+	parent *Node[K, V]
+	black  bool
+}
+
+// Return the node with the next greater key, nil if node is the last one
+func (n *Node[K, V]) Next() *Node[K, V] {
+	if n == nil {
+		return nil
+	}
+	if n.right != nil {
+		result := n.right
+		for result.left != nil {
+			result = result.left
+		}
+		return result
+	}
+	parent := n.parent
+	child := n
+	for parent != nil && child == parent.right {
+		child = parent
+		parent = parent.parent
+	}
+	return parent
+}
+
+// Return the node with the next smaller key, nil if node is the first one
+func (n *Node[K, V]) Prev() *Node[K, V] {
+	if n == nil {
+		return nil
+	}
+	if n.left != nil {
+		result := n.left
+		for result.right != nil {
+			result = result.right
+		}
+		return result
+	}
+	parent := n.parent
+	child := n
+	for parent != nil && child == parent.left {
+		child = parent
+		parent = parent.parent
+	}
+	return parent
+}
+
+// Tells whether deleting this node will move its successor's content into it
+func (n *Node[K, V]) HasTwoChildren() bool {
+	return n.left != nil && n.right != nil
+}
+
+type Tree[K any, V any] struct {
+	root       *Node[K, V]
+	size       int
+	Comparator func(K, K) int
+}
+
+func New[K any, V any](comparator func(K, K) int) *Tree[K, V] {
+	if comparator == nil {
+		panic("Comparator can't be nil")
+	}
+	return &Tree[K, V]{Comparator: comparator}
+}
+
+func (v *Tree[K, V]) Size() int {
+	return v.size
+}
+
+func (v *Tree[K, V]) Clear() {
+	v.root = nil
+	v.size = 0
+}
+
+// Return the node holding key, or nil
+func (v *Tree[K, V]) Get(key K) *Node[K, V] {
+	node := v.root
+	for node != nil {
+		cmp := v.Comparator(key, node.Key)
+		if cmp < 0 {
+			node = node.left
+		} else if cmp > 0 {
+			node = node.right
+		} else {
+			return node
+		}
+	}
+	return nil
+}
+
+func (v *Tree[K, V]) First() *Node[K, V] {
+	node := v.root
+	if node != nil {
+		for node.left != nil {
+			node = node.left
+		}
+	}
+	return node
+}
+
+func (v *Tree[K, V]) Last() *Node[K, V] {
+	node := v.root
+	if node != nil {
+		for node.right != nil {
+			node = node.right
+		}
+	}
+	return node
+}
+
+// Search the tree for key. When the key is found, the node is returned if inclusive is set, otherwise
+// its neighbour in the requested direction is returned. When the key is not found, the closest node in
+// the requested direction is returned.
+func (v *Tree[K, V]) closest(key K, greater bool, inclusive bool) *Node[K, V] {
+	var candidate *Node[K, V]
+	node := v.root
+	for node != nil {
+		cmp := v.Comparator(key, node.Key)
+		if cmp == 0 && inclusive {
+			return node
+		}
+		if greater {
+			if cmp < 0 {
+				candidate = node
+				node = node.left
+			} else {
+				node = node.right
+			}
+		} else {
+			if cmp > 0 {
+				candidate = node
+				node = node.right
+			} else {
+				node = node.left
+			}
+		}
+	}
+	return candidate
+}
+
+// Least node with key >= given key
+func (v *Tree[K, V]) Ceiling(key K) *Node[K, V] {
+	return v.closest(key, true, true)
+}
+
+// Least node with key > given key
+func (v *Tree[K, V]) Higher(key K) *Node[K, V] {
+	return v.closest(key, true, false)
+}
+
+// Greatest node with key <= given key
+func (v *Tree[K, V]) Floor(key K) *Node[K, V] {
+	return v.closest(key, false, true)
+}
+
+// Greatest node with key < given key
+func (v *Tree[K, V]) Lower(key K) *Node[K, V] {
+	return v.closest(key, false, false)
+}
+
+// Insert key with value, or replace the value if key exists.
+// Returns the node holding the key, and whether a new node was added
+func (v *Tree[K, V]) Put(key K, value V) (*Node[K, V], bool) {
+	if v.root == nil {
+		v.root = &Node[K, V]{Key: key, Value: value, black: true}
+		v.size = 1
+		return v.root, true
+	}
+	var parent *Node[K, V]
+	cmp := 0
+	node := v.root
+	for node != nil {
+		parent = node
+		cmp = v.Comparator(key, node.Key)
+		if cmp < 0 {
+			node = node.left
+		} else if cmp > 0 {
+			node = node.right
+		} else {
+			node.Value = value
+			return node, false
+		}
+	}
+	newNode := &Node[K, V]{Key: key, Value: value, parent: parent}
+	if cmp < 0 {
+		parent.left = newNode
+	} else {
+		parent.right = newNode
+	}
+	v.fixAfterInsertion(newNode)
+	v.size++
+	return newNode, true
+}
+
+// Remove node from the tree. When the node has two children, its successor's content is moved into
+// node and the successor is unlinked instead.
+func (v *Tree[K, V]) Delete(node *Node[K, V]) {
+	v.size--
+	if node.left != nil && node.right != nil {
+		next := node.Next()
+		node.Key = next.Key
+		node.Value = next.Value
+		node = next
+	}
+
+	var replacement *Node[K, V]
+	if node.left != nil {
+		replacement = node.left
+	} else {
+		replacement = node.right
+	}
+
+	if replacement != nil {
+		replacement.parent = node.parent
+		if node.parent == nil {
+			v.root = replacement
+		} else if node == node.parent.left {
+			node.parent.left = replacement
+		} else {
+			node.parent.right = replacement
+		}
+		node.left = nil
+		node.right = nil
+		node.parent = nil
+		if node.black {
+			v.fixAfterDeletion(replacement)
+		}
+	} else if node.parent == nil {
+		v.root = nil
+	} else {
+		if node.black {
+			v.fixAfterDeletion(node)
+		}
+		if node.parent != nil {
+			if node == node.parent.left {
+				node.parent.left = nil
+			} else if node == node.parent.right {
+				node.parent.right = nil
+			}
+			node.parent = nil
+		}
+	}
+}
+
+func isBlack[K any, V any](node *Node[K, V]) bool {
+	return node == nil || node.black
+}
+
+func setBlack[K any, V any](node *Node[K, V], black bool) {
+	if node != nil {
+		node.black = black
+	}
+}
+
+func parentOf[K any, V any](node *Node[K, V]) *Node[K, V] {
+	if node == nil {
+		return nil
+	}
+	return node.parent
+}
+
+func leftOf[K any, V any](node *Node[K, V]) *Node[K, V] {
+	if node == nil {
+		return nil
+	}
+	return node.left
+}
+
+func rightOf[K any, V any](node *Node[K, V]) *Node[K, V] {
+	if node == nil {
+		return nil
+	}
+	return node.right
+}
+
+func (v *Tree[K, V]) rotateLeft(node *Node[K, V]) {
+	if node == nil {
+		return
+	}
+	right := node.right
+	node.right = right.left
+	if right.left != nil {
+		right.left.parent = node
+	}
+	right.parent = node.parent
+	if node.parent == nil {
+		v.root = right
+	} else if node.parent.left == node {
+		node.parent.left = right
+	} else {
+		node.parent.right = right
+	}
+	right.left = node
+	node.parent = right
+}
+
+func (v *Tree[K, V]) rotateRight(node *Node[K, V]) {
+	if node == nil {
+		return
+	}
+	left := node.left
+	node.left = left.right
+	if left.right != nil {
+		left.right.parent = node
+	}
+	left.parent = node.parent
+	if node.parent == nil {
+		v.root = left
+	} else if node.parent.right == node {
+		node.parent.right = left
+	} else {
+		node.parent.left = left
+	}
+	left.right = node
+	node.parent = left
+}
+
+func (v *Tree[K, V]) fixAfterInsertion(x *Node[K, V]) {
+	x.black = false
+	for x != nil && x != v.root && !x.parent.black {
+		if parentOf(x) == leftOf(parentOf(parentOf(x))) {
+			y := rightOf(parentOf(parentOf(x)))
+			if !isBlack(y) {
+				setBlack(parentOf(x), true)
+				setBlack(y, true)
+				setBlack(parentOf(parentOf(x)), false)
+				x = parentOf(parentOf(x))
+			} else {
+				if x == rightOf(parentOf(x)) {
+					x = parentOf(x)
+					v.rotateLeft(x)
+				}
+				setBlack(parentOf(x), true)
+				setBlack(parentOf(parentOf(x)), false)
+				v.rotateRight(parentOf(parentOf(x)))
+			}
+		} else {
+			y := leftOf(parentOf(parentOf(x)))
+			if !isBlack(y) {
+				setBlack(parentOf(x), true)
+				setBlack(y, true)
+				setBlack(parentOf(parentOf(x)), false)
+				x = parentOf(parentOf(x))
+			} else {
+				if x == leftOf(parentOf(x)) {
+					x = parentOf(x)
+					v.rotateRight(x)
+				}
+				setBlack(parentOf(x), true)
+				setBlack(parentOf(parentOf(x)), false)
+				v.rotateLeft(parentOf(parentOf(x)))
+			}
+		}
+	}
+	v.root.black = true
+}
+
+func (v *Tree[K, V]) fixAfterDeletion(x *Node[K, V]) {
+	for x != v.root && isBlack(x) {
+		if x == leftOf(parentOf(x)) {
+			sib := rightOf(parentOf(x))
+			if !isBlack(sib) {
+				setBlack(sib, true)
+				setBlack(parentOf(x), false)
+				v.rotateLeft(parentOf(x))
+				sib = rightOf(parentOf(x))
+			}
+			if isBlack(leftOf(sib)) && isBlack(rightOf(sib)) {
+				setBlack(sib, false)
+				x = parentOf(x)
+			} else {
+				if isBlack(rightOf(sib)) {
+					setBlack(leftOf(sib), true)
+					setBlack(sib, false)
+					v.rotateRight(sib)
+					sib = rightOf(parentOf(x))
+				}
+				setBlack(sib, isBlack(parentOf(x)))
+				setBlack(parentOf(x), true)
+				setBlack(rightOf(sib), true)
+				v.rotateLeft(parentOf(x))
+				x = v.root
+			}
+		} else {
+			sib := leftOf(parentOf(x))
+			if !isBlack(sib) {
+				setBlack(sib, true)
+				setBlack(parentOf(x), false)
+				v.rotateRight(parentOf(x))
+				sib = leftOf(parentOf(x))
+			}
+			if isBlack(rightOf(sib)) && isBlack(leftOf(sib)) {
+				setBlack(sib, false)
+				x = parentOf(x)
+			} else {
+				if isBlack(leftOf(sib)) {
+					setBlack(rightOf(sib), true)
+					setBlack(sib, false)
+					v.rotateLeft(sib)
+					sib = leftOf(parentOf(x))
+				}
+				setBlack(sib, isBlack(parentOf(x)))
+				setBlack(parentOf(x), true)
+				setBlack(leftOf(sib), true)
+				v.rotateRight(parentOf(x))
+				x = v.root
+			}
+		}
+	}
+	setBlack(x, true)
+}